@@ -1,10 +1,14 @@
 package aws
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
+	"runtime"
 	"strings"
 	"time"
 
@@ -18,9 +22,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/go-ini/ini"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string) (string, string, error) {
@@ -109,7 +115,106 @@ func parseAccountInfoFromArn(arn string) (string, string, error) {
 // environment in the case that they're not explicitly specified
 // in the Terraform configuration.
 func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
-	// build a chain provider, lazy-evaluated by aws-sdk
+	var providers []awsCredentials.Provider
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		// An explicitly configured web identity is used as the base credentials,
+		// it must not be overridden by static, environment or shared credentials.
+		providers = []awsCredentials.Provider{newWebIdentityProvider(c, c.AssumeRoleWithWebIdentity)}
+		log.Printf("[INFO] assume_role_with_web_identity configured, using WebIdentityCredentialsProvider as base credentials")
+	} else {
+		var err error
+		providers, err = getDefaultCredentialProviders(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// This is the "normal" flow (i.e. not assuming a role)
+	if len(c.AssumeRoles) == 0 {
+		return awsCredentials.NewChainCredentials(providers), nil
+	}
+
+	// Otherwise we need to construct an STS client with the main credentials, and verify
+	// that we can assume each of the defined roles in turn.
+	creds := awsCredentials.NewChainCredentials(providers)
+	cp, err := creds.Get()
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+			return nil, errors.New(`No valid credential sources found for AWS Provider.
+  Please see https://terraform.io/docs/providers/aws/index.html for more information on
+  providing credentials for the AWS Provider`)
+		}
+
+		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+	}
+
+	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+
+	for _, ar := range c.AssumeRoles {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, DurationSeconds: %d)",
+			ar.RoleARN, ar.SessionName, ar.ExternalID, ar.Policy, ar.DurationSeconds)
+
+		assumeRoleProvider := &stscreds.AssumeRoleProvider{
+			Client:  stsClientForCredentials(c, creds),
+			RoleARN: ar.RoleARN,
+		}
+		if ar.SessionName != "" {
+			assumeRoleProvider.RoleSessionName = ar.SessionName
+		}
+		if ar.ExternalID != "" {
+			assumeRoleProvider.ExternalID = aws.String(ar.ExternalID)
+		}
+		if ar.Policy != "" {
+			assumeRoleProvider.Policy = aws.String(ar.Policy)
+		}
+		if ar.DurationSeconds != 0 {
+			assumeRoleProvider.Duration = time.Duration(ar.DurationSeconds) * time.Second
+		}
+
+		providers = []awsCredentials.Provider{assumeRoleProvider}
+
+		assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
+		_, err = assumeRoleCreds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
+					"  There are a number of possible causes of this - the most common are:\n"+
+					"    * The credentials used in order to assume the role are invalid\n"+
+					"    * The credentials do not have appropriate permission to assume the role\n"+
+					"    * The role ARN is not valid",
+					ar.RoleARN)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		}
+
+		creds = assumeRoleCreds
+	}
+
+	return creds, nil
+}
+
+// stsClientForCredentials returns an STS client signing requests with the
+// given credentials, honouring the provider's STS endpoint override.
+func stsClientForCredentials(c *Config, creds *awsCredentials.Credentials) *sts.STS {
+	awsConfig := &aws.Config{
+		Credentials:      creds,
+		Region:           aws.String(c.Region),
+		MaxRetries:       aws.Int(c.MaxRetries),
+		HTTPClient:       cleanhttp.DefaultClient(),
+		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	}
+	if endpoint := c.Endpoints["sts"]; endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+
+	return sts.New(session.New(awsConfig))
+}
+
+// getDefaultCredentialProviders builds the chain of credential providers used
+// when no web identity is configured explicitly, lazy-evaluated by aws-sdk.
+func getDefaultCredentialProviders(c *Config) ([]awsCredentials.Provider, error) {
 	providers := []awsCredentials.Provider{
 		&awsCredentials.StaticProvider{Value: awsCredentials.Value{
 			AccessKeyID:     c.AccessKey,
@@ -123,6 +228,24 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		},
 	}
 
+	credentialProcess, err := getCredentialProcess(c)
+	if err != nil {
+		return nil, err
+	}
+	if credentialProcess != "" {
+		providers = append(providers, &credentialProcessProvider{
+			Command:      credentialProcess,
+			ExpiryWindow: 5 * time.Minute,
+		})
+		log.Printf("[INFO] credential_process found in shared config, CredentialProcessProvider added to auth chain")
+	}
+
+	if webIdentity := getWebIdentityConfig(c); webIdentity != nil {
+		providers = append(providers, newWebIdentityProvider(c, webIdentity))
+		log.Printf("[INFO] Web identity token file %q configured, WebIdentityCredentialsProvider added to auth chain",
+			webIdentity.WebIdentityTokenFile)
+	}
+
 	// Build isolated HTTP client to avoid issues with globally-shared settings
 	client := cleanhttp.DefaultClient()
 
@@ -176,71 +299,245 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		}
 	}
 
-	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
-		return awsCredentials.NewChainCredentials(providers), nil
+	return providers, nil
+}
+
+func newWebIdentityProvider(c *Config, webIdentity *AssumeRoleWithWebIdentity) *webIdentityProvider {
+	p := &webIdentityProvider{
+		Client:               stsClientForCredentials(c, awsCredentials.AnonymousCredentials),
+		RoleARN:              webIdentity.RoleARN,
+		RoleSessionName:      webIdentity.SessionName,
+		WebIdentityTokenFile: webIdentity.WebIdentityTokenFile,
+		ExpiryWindow:         5 * time.Minute,
+	}
+	if webIdentity.Policy != "" {
+		p.Policy = aws.String(webIdentity.Policy)
+	}
+	if webIdentity.DurationSeconds != 0 {
+		p.Duration = time.Duration(webIdentity.DurationSeconds) * time.Second
+	}
+	return p
+}
+
+// getWebIdentityConfig returns the assume_role_with_web_identity settings,
+// falling back to the AWS_ROLE_ARN, AWS_WEB_IDENTITY_TOKEN_FILE and
+// AWS_ROLE_SESSION_NAME environment variables.
+func getWebIdentityConfig(c *Config) *AssumeRoleWithWebIdentity {
+	if c.AssumeRoleWithWebIdentity != nil {
+		return c.AssumeRoleWithWebIdentity
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
+	roleARN := os.Getenv("AWS_ROLE_ARN")
+	tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	if roleARN == "" || tokenFile == "" {
+		return nil
+	}
 
-	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
+	return &AssumeRoleWithWebIdentity{
+		RoleARN:              roleARN,
+		SessionName:          os.Getenv("AWS_ROLE_SESSION_NAME"),
+		WebIdentityTokenFile: tokenFile,
+	}
+}
+
+const webIdentityProviderName = "WebIdentityCredentialsProvider"
+
+// webIdentityProvider retrieves credentials by calling
+// sts:AssumeRoleWithWebIdentity with a token read from a file. The file is
+// re-read on every retrieval as tokens are typically rotated by an agent.
+type webIdentityProvider struct {
+	awsCredentials.Expiry
+
+	Client *sts.STS
+
+	RoleARN              string
+	RoleSessionName      string
+	WebIdentityTokenFile string
+	Policy               *string
+	Duration             time.Duration
+
+	ExpiryWindow time.Duration
+}
+
+func (p *webIdentityProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.WebIdentityTokenFile)
 	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
-  Please see https://terraform.io/docs/providers/aws/index.html for more information on
-  providing credentials for the AWS Provider`)
-		}
+		return awsCredentials.Value{ProviderName: webIdentityProviderName},
+			fmt.Errorf("Error reading web identity token file %q: %s", p.WebIdentityTokenFile, err)
+	}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+	sessionName := p.RoleSessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+		Policy:           p.Policy,
+	}
+	if p.Duration != 0 {
+		input.DurationSeconds = aws.Int64(int64(p.Duration / time.Second))
+	}
 
-	awsConfig := &aws.Config{
-		Credentials:      creds,
-		Region:           aws.String(c.Region),
-		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	out, err := p.Client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityProviderName},
+			fmt.Errorf("Error assuming role %q with web identity: %s", p.RoleARN, err)
 	}
 
-	stsclient := sts.New(session.New(awsConfig))
-	assumeRoleProvider := &stscreds.AssumeRoleProvider{
-		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+	p.SetExpiration(*out.Credentials.Expiration, p.ExpiryWindow)
+
+	return awsCredentials.Value{
+		AccessKeyID:     *out.Credentials.AccessKeyId,
+		SecretAccessKey: *out.Credentials.SecretAccessKey,
+		SessionToken:    *out.Credentials.SessionToken,
+		ProviderName:    webIdentityProviderName,
+	}, nil
+}
+
+// getCredentialProcess looks up the credential_process setting of the
+// configured profile, first in the shared credentials file and then in the
+// shared config file.
+func getCredentialProcess(c *Config) (string, error) {
+	profile := c.Profile
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if profile == "" {
+		profile = "default"
+	}
+
+	credsFile := c.CredsFilename
+	if credsFile == "" {
+		credsFile = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if credsFile == "" {
+		credsFile = "~/.aws/credentials"
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	if configFile == "" {
+		configFile = "~/.aws/config"
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	configSection := "profile " + profile
+	if profile == "default" {
+		configSection = profile
+	}
 
-	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
-	_, err = assumeRoleCreds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
-				"  There are a number of possible causes of this - the most common are:\n"+
-				"    * The credentials used in order to assume the role are invalid\n"+
-				"    * The credentials do not have appropriate permission to assume the role\n"+
-				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+	files := []struct {
+		path    string
+		section string
+	}{
+		{credsFile, profile},
+		{configFile, configSection},
+	}
+	for _, f := range files {
+		path, err := homedir.Expand(f.path)
+		if err != nil {
+			return "", err
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		cfg, err := ini.Load(path)
+		if err != nil {
+			return "", fmt.Errorf("Error loading shared config file %q: %s", path, err)
+		}
+		section, err := cfg.GetSection(f.section)
+		if err != nil {
+			continue
+		}
+		if v := section.Key("credential_process").String(); v != "" {
+			return v, nil
+		}
 	}
 
-	return assumeRoleCreds, nil
+	return "", nil
+}
+
+const credentialProcessProviderName = "CredentialProcessProvider"
+
+// credentialProcessProvider retrieves credentials from the JSON output of an
+// external command, as configured with credential_process in shared config.
+type credentialProcessProvider struct {
+	Command string
+
+	ExpiryWindow time.Duration
+
+	retrieved  bool
+	expiration *time.Time
+}
+
+type credentialProcessOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+func (p *credentialProcessProvider) Retrieve() (awsCredentials.Value, error) {
+	p.retrieved = false
+	p.expiration = nil
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", p.Command)
+	} else {
+		cmd = exec.Command("sh", "-c", p.Command)
+	}
+	cmd.Env = os.Environ()
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+			fmt.Errorf("Error running credential_process %q: %s", p.Command, err)
+	}
+
+	var resp credentialProcessOutput
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+			fmt.Errorf("Error parsing credential_process %q output: %s", p.Command, err)
+	}
+	if resp.Version != 1 {
+		return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+			fmt.Errorf("Unsupported credential_process output version: %d", resp.Version)
+	}
+	if resp.AccessKeyId == "" || resp.SecretAccessKey == "" {
+		return awsCredentials.Value{ProviderName: credentialProcessProviderName},
+			fmt.Errorf("credential_process %q returned no AccessKeyId or SecretAccessKey", p.Command)
+	}
+
+	p.retrieved = true
+	if resp.Expiration != nil {
+		expiration := resp.Expiration.Add(-p.ExpiryWindow)
+		p.expiration = &expiration
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     resp.AccessKeyId,
+		SecretAccessKey: resp.SecretAccessKey,
+		SessionToken:    resp.SessionToken,
+		ProviderName:    credentialProcessProviderName,
+	}, nil
+}
+
+// IsExpired returns true if the credentials have not been retrieved yet or
+// the expiration returned by the process has passed. Credentials returned
+// without an expiration never expire.
+func (p *credentialProcessProvider) IsExpired() bool {
+	if !p.retrieved {
+		return true
+	}
+	if p.expiration == nil {
+		return false
+	}
+	return p.expiration.Before(time.Now())
 }
 
 func setOptionalEndpoint(cfg *aws.Config) string {
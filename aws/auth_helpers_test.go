@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"runtime"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -671,6 +672,392 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_assumeRoleChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=3600&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Ffirst&RoleSessionName=first&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsAssumeRoleResponse("first"), "text/xml"},
+		},
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&ExternalId=external&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fsecond&RoleSessionName=second&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsAssumeRoleResponse("second"), "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := GetCredentials(&Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN:         "arn:aws:iam::111111111111:role/first",
+				SessionName:     "first",
+				DurationSeconds: 3600,
+			},
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/second",
+				SessionName: "second",
+				ExternalID:  "external",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "ASIAsecond" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "ASIAsecond", v.AccessKeyID)
+	}
+	if v.SessionToken != "tokensecond" {
+		t.Fatalf("SessionToken mismatch, expected (%s), got (%s)", "tokensecond", v.SessionToken)
+	}
+}
+
+func TestAWSGetCredentials_assumeRoleChainShouldError(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Ffirst&RoleSessionName=first&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsAssumeRoleResponse("first"), "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = GetCredentials(&Config{
+		AccessKey:            "accessKey",
+		SecretKey:            "secretKey",
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN:     "arn:aws:iam::111111111111:role/first",
+				SessionName: "first",
+			},
+			{
+				RoleARN:     "arn:aws:iam::222222222222:role/unknown",
+				SessionName: "unknown",
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("Expected an error assuming the second role")
+	}
+}
+
+func TestAWSGetCredentials_webIdentity(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	tokenFile := writeTempFile(t, "terraform_aws_web_identity", "web-identity-token\n")
+	defer os.Remove(tokenFile)
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity&DurationSeconds=1800&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fweb&RoleSessionName=web&" +
+				"Version=2011-06-15&WebIdentityToken=web-identity-token"},
+			Response: &awsMockResponse{200, stsAssumeRoleWithWebIdentityResponse("web"), "text/xml"},
+		},
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRole&DurationSeconds=900&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A222222222222%3Arole%2Fchained&RoleSessionName=chained&Version=2011-06-15"},
+			Response: &awsMockResponse{200, stsAssumeRoleResponse("chained"), "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &Config{
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/web",
+			SessionName:          "web",
+			WebIdentityTokenFile: tokenFile,
+			DurationSeconds:      1800,
+		},
+	}
+
+	creds, err := GetCredentials(cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.ProviderName != webIdentityProviderName {
+		t.Fatalf("ProviderName mismatch, expected (%s), got (%s)", webIdentityProviderName, v.ProviderName)
+	}
+	if v.AccessKeyID != "ASIAweb" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "ASIAweb", v.AccessKeyID)
+	}
+
+	// The web identity credentials are used to assume further roles
+	cfg.AssumeRoles = []*AssumeRole{
+		{
+			RoleARN:     "arn:aws:iam::222222222222:role/chained",
+			SessionName: "chained",
+		},
+	}
+	creds, err = GetCredentials(cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	v, err = creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "ASIAchained" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "ASIAchained", v.AccessKeyID)
+	}
+}
+
+func TestAWSGetCredentials_webIdentityOverridesEnv(t *testing.T) {
+	resetEnv := setEnv("some_env", t)
+	defer resetEnv()
+
+	tokenFile := writeTempFile(t, "terraform_aws_web_identity", "web-identity-token")
+	defer os.Remove(tokenFile)
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fweb&RoleSessionName=web&" +
+				"Version=2011-06-15&WebIdentityToken=web-identity-token"},
+			Response: &awsMockResponse{200, stsAssumeRoleWithWebIdentityResponse("web"), "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := GetCredentials(&Config{
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/web",
+			SessionName:          "web",
+			WebIdentityTokenFile: tokenFile,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.ProviderName != webIdentityProviderName {
+		t.Fatalf("ProviderName mismatch, expected (%s), got (%s)", webIdentityProviderName, v.ProviderName)
+	}
+	if v.AccessKeyID != "ASIAweb" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "ASIAweb", v.AccessKeyID)
+	}
+}
+
+func TestAWSGetCredentials_webIdentityFromEnv(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	tokenFile := writeTempFile(t, "terraform_aws_web_identity", "web-identity-token")
+	defer os.Remove(tokenFile)
+
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::111111111111:role/web")
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	os.Setenv("AWS_ROLE_SESSION_NAME", "env")
+
+	closeSts, stsSess, err := getMockedAwsApiSession("STS", []*awsMockEndpoint{
+		{
+			Request: &awsMockRequest{"POST", "/", "Action=AssumeRoleWithWebIdentity&" +
+				"RoleArn=arn%3Aaws%3Aiam%3A%3A111111111111%3Arole%2Fweb&RoleSessionName=env&" +
+				"Version=2011-06-15&WebIdentityToken=web-identity-token"},
+			Response: &awsMockResponse{200, stsAssumeRoleWithWebIdentityResponse("env"), "text/xml"},
+		},
+	})
+	defer closeSts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := GetCredentials(&Config{
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": *stsSess.Config.Endpoint},
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "ASIAenv" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "ASIAenv", v.AccessKeyID)
+	}
+}
+
+func TestAWSGetCredentials_credentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test relies on a POSIX shell")
+	}
+
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	output := writeTempFile(t, "terraform_aws_process", `{
+  "Version": 1,
+  "AccessKeyId": "processAccessKey",
+  "SecretAccessKey": "processSecretKey",
+  "SessionToken": "processToken",
+  "Expiration": "2099-01-01T00:00:00Z"
+}`)
+	defer os.Remove(output)
+
+	configFile := writeTempFile(t, "terraform_aws_config", fmt.Sprintf(`[default]
+region = us-east-1
+
+[profile process]
+credential_process = cat %s
+`, output))
+	defer os.Remove(configFile)
+
+	os.Setenv("AWS_CONFIG_FILE", configFile)
+
+	creds, err := GetCredentials(&Config{
+		Profile:              "process",
+		CredsFilename:        "/nonexistent/aws/credentials",
+		SkipMetadataApiCheck: true,
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.ProviderName != credentialProcessProviderName {
+		t.Fatalf("ProviderName mismatch, expected (%s), got (%s)", credentialProcessProviderName, v.ProviderName)
+	}
+	if v.AccessKeyID != "processAccessKey" {
+		t.Fatalf("AccessKeyID mismatch, expected (%s), got (%s)", "processAccessKey", v.AccessKeyID)
+	}
+	if v.SecretAccessKey != "processSecretKey" {
+		t.Fatalf("SecretAccessKey mismatch, expected (%s), got (%s)", "processSecretKey", v.SecretAccessKey)
+	}
+	if v.SessionToken != "processToken" {
+		t.Fatalf("SessionToken mismatch, expected (%s), got (%s)", "processToken", v.SessionToken)
+	}
+	if creds.IsExpired() {
+		t.Fatal("Expected credentials with a future expiration not to be expired")
+	}
+}
+
+func TestAWSCredentialProcessProvider_expired(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test relies on a POSIX shell")
+	}
+
+	p := &credentialProcessProvider{
+		Command: `echo '{"Version": 1, "AccessKeyId": "a", "SecretAccessKey": "s", "Expiration": "2000-01-01T00:00:00Z"}'`,
+	}
+	if !p.IsExpired() {
+		t.Fatal("Expected credentials to be expired before retrieval")
+	}
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("Error retrieving creds: %s", err)
+	}
+	if !p.IsExpired() {
+		t.Fatal("Expected credentials with a past expiration to be expired")
+	}
+
+	p.Command = `echo '{"Version": 2, "AccessKeyId": "a", "SecretAccessKey": "s"}'`
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatal("Expected an error for an unsupported output version")
+	}
+}
+
+func stsAssumeRoleResponse(name string) string {
+	return fmt.Sprintf(`<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>ASIA%[1]s</AccessKeyId>
+      <SecretAccessKey>secret%[1]s</SecretAccessKey>
+      <SessionToken>token%[1]s</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/%[1]s/%[1]s</Arn>
+      <AssumedRoleId>AROA%[1]s:%[1]s</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`, name)
+}
+
+func stsAssumeRoleWithWebIdentityResponse(name string) string {
+	return fmt.Sprintf(`<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>ASIA%[1]s</AccessKeyId>
+      <SecretAccessKey>secret%[1]s</SecretAccessKey>
+      <SessionToken>token%[1]s</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/%[1]s/%[1]s</Arn>
+      <AssumedRoleId>AROA%[1]s:%[1]s</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`, name)
+}
+
+func writeTempFile(t *testing.T, prefix, contents string) string {
+	file, err := ioutil.TempFile(os.TempDir(), prefix)
+	if err != nil {
+		t.Fatalf("Error creating temporary file: %s", err)
+	}
+	if _, err := file.WriteString(contents); err != nil {
+		t.Fatalf("Error writing temporary file: %s", err)
+	}
+	if err := file.Close(); err != nil {
+		t.Fatalf("Error closing temporary file: %s", err)
+	}
+	return file.Name()
+}
+
 func testGetAccountInfo(t *testing.T, iamSess, stsSess *session.Session, credProviderName string) {
 
 	iamConn := iam.New(iamSess)
@@ -713,6 +1100,18 @@ func unsetEnv(t *testing.T) func() {
 	if err := os.Unsetenv("AWS_SHARED_CREDENTIALS_FILE"); err != nil {
 		t.Fatalf("Error unsetting env var AWS_SHARED_CREDENTIALS_FILE: %s", err)
 	}
+	if err := os.Setenv("AWS_CONFIG_FILE", "/nonexistent/aws/config"); err != nil {
+		t.Fatalf("Error setting env var AWS_CONFIG_FILE: %s", err)
+	}
+	if err := os.Unsetenv("AWS_ROLE_ARN"); err != nil {
+		t.Fatalf("Error unsetting env var AWS_ROLE_ARN: %s", err)
+	}
+	if err := os.Unsetenv("AWS_WEB_IDENTITY_TOKEN_FILE"); err != nil {
+		t.Fatalf("Error unsetting env var AWS_WEB_IDENTITY_TOKEN_FILE: %s", err)
+	}
+	if err := os.Unsetenv("AWS_ROLE_SESSION_NAME"); err != nil {
+		t.Fatalf("Error unsetting env var AWS_ROLE_SESSION_NAME: %s", err)
+	}
 
 	return func() {
 		// re-set all the envs we unset above
@@ -731,6 +1130,18 @@ func unsetEnv(t *testing.T) func() {
 		if err := os.Setenv("AWS_SHARED_CREDENTIALS_FILE", e.CredsFilename); err != nil {
 			t.Fatalf("Error resetting env var AWS_SHARED_CREDENTIALS_FILE: %s", err)
 		}
+		if err := os.Setenv("AWS_CONFIG_FILE", e.ConfigFilename); err != nil {
+			t.Fatalf("Error resetting env var AWS_CONFIG_FILE: %s", err)
+		}
+		if err := os.Setenv("AWS_ROLE_ARN", e.RoleARN); err != nil {
+			t.Fatalf("Error resetting env var AWS_ROLE_ARN: %s", err)
+		}
+		if err := os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", e.WebIdentityTokenFile); err != nil {
+			t.Fatalf("Error resetting env var AWS_WEB_IDENTITY_TOKEN_FILE: %s", err)
+		}
+		if err := os.Setenv("AWS_ROLE_SESSION_NAME", e.RoleSessionName); err != nil {
+			t.Fatalf("Error resetting env var AWS_ROLE_SESSION_NAME: %s", err)
+		}
 	}
 }
 
@@ -816,12 +1227,18 @@ func getEnv() *currentEnv {
 		Token:         os.Getenv("AWS_SESSION_TOKEN"),
 		Profile:       os.Getenv("AWS_PROFILE"),
 		CredsFilename: os.Getenv("AWS_SHARED_CREDENTIALS_FILE"),
+
+		ConfigFilename:       os.Getenv("AWS_CONFIG_FILE"),
+		RoleARN:              os.Getenv("AWS_ROLE_ARN"),
+		WebIdentityTokenFile: os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"),
+		RoleSessionName:      os.Getenv("AWS_ROLE_SESSION_NAME"),
 	}
 }
 
 // struct to preserve the current environment
 type currentEnv struct {
-	Key, Secret, Token, Profile, CredsFilename string
+	Key, Secret, Token, Profile, CredsFilename                     string
+	ConfigFilename, RoleARN, WebIdentityTokenFile, RoleSessionName string
 }
 
 type endpoint struct {
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -52,7 +51,7 @@ func autoscalingTagToHash(v interface{}) int {
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tag"
-func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) error {
+func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
 	resourceID := d.Get("name").(string)
 	var createTags, removeTags []*autoscaling.Tag
 
@@ -61,17 +60,17 @@ func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) e
 		o := setToMapByKey(oraw.(*schema.Set), "key")
 		n := setToMapByKey(nraw.(*schema.Set), "key")
 
-		old, err := autoscalingTagsFromMap(o, resourceID)
+		old, err := autoscalingTagsFromMap(o, resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
 
-		new, err := autoscalingTagsFromMap(n, resourceID)
+		new, err := autoscalingTagsFromMap(n, resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
 
-		c, r, err := diffAutoscalingTags(old, new, resourceID)
+		c, r, err := diffAutoscalingTags(old, new, resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
@@ -80,17 +79,17 @@ func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) e
 		removeTags = append(removeTags, r...)
 
 		oraw, nraw = d.GetChange("tags")
-		old, err = autoscalingTagsFromList(oraw.([]interface{}), resourceID)
+		old, err = autoscalingTagsFromList(oraw.([]interface{}), resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
 
-		new, err = autoscalingTagsFromList(nraw.([]interface{}), resourceID)
+		new, err = autoscalingTagsFromList(nraw.([]interface{}), resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
 
-		c, r, err = diffAutoscalingTags(old, new, resourceID)
+		c, r, err = diffAutoscalingTags(old, new, resourceID, ignoreConfig)
 		if err != nil {
 			return err
 		}
@@ -130,7 +129,7 @@ func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) e
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffAutoscalingTags(oldTags, newTags []*autoscaling.Tag, resourceID string) ([]*autoscaling.Tag, []*autoscaling.Tag, error) {
+func diffAutoscalingTags(oldTags, newTags []*autoscaling.Tag, resourceID string, ignoreConfig *ignoreTagsConfig) ([]*autoscaling.Tag, []*autoscaling.Tag, error) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -153,7 +152,7 @@ func diffAutoscalingTags(oldTags, newTags []*autoscaling.Tag, resourceID string)
 		}
 	}
 
-	createTags, err := autoscalingTagsFromMap(create, resourceID)
+	createTags, err := autoscalingTagsFromMap(create, resourceID, ignoreConfig)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -161,7 +160,7 @@ func diffAutoscalingTags(oldTags, newTags []*autoscaling.Tag, resourceID string)
 	return createTags, remove, nil
 }
 
-func autoscalingTagsFromList(vs []interface{}, resourceID string) ([]*autoscaling.Tag, error) {
+func autoscalingTagsFromList(vs []interface{}, resourceID string, ignoreConfig *ignoreTagsConfig) ([]*autoscaling.Tag, error) {
 	result := make([]*autoscaling.Tag, 0, len(vs))
 	for _, tag := range vs {
 		attr, ok := tag.(map[string]interface{})
@@ -169,7 +168,7 @@ func autoscalingTagsFromList(vs []interface{}, resourceID string) ([]*autoscalin
 			continue
 		}
 
-		t, err := autoscalingTagFromMap(attr, resourceID)
+		t, err := autoscalingTagFromMap(attr, resourceID, ignoreConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -182,7 +181,7 @@ func autoscalingTagsFromList(vs []interface{}, resourceID string) ([]*autoscalin
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func autoscalingTagsFromMap(m map[string]interface{}, resourceID string) ([]*autoscaling.Tag, error) {
+func autoscalingTagsFromMap(m map[string]interface{}, resourceID string, ignoreConfig *ignoreTagsConfig) ([]*autoscaling.Tag, error) {
 	result := make([]*autoscaling.Tag, 0, len(m))
 	for _, v := range m {
 		attr, ok := v.(map[string]interface{})
@@ -190,7 +189,7 @@ func autoscalingTagsFromMap(m map[string]interface{}, resourceID string) ([]*aut
 			continue
 		}
 
-		t, err := autoscalingTagFromMap(attr, resourceID)
+		t, err := autoscalingTagFromMap(attr, resourceID, ignoreConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -203,7 +202,7 @@ func autoscalingTagsFromMap(m map[string]interface{}, resourceID string) ([]*aut
 	return result, nil
 }
 
-func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*autoscaling.Tag, error) {
+func autoscalingTagFromMap(attr map[string]interface{}, resourceID string, ignoreConfig *ignoreTagsConfig) (*autoscaling.Tag, error) {
 	if _, ok := attr["key"]; !ok {
 		return nil, fmt.Errorf("%s: invalid tag attributes: key missing", resourceID)
 	}
@@ -241,7 +240,7 @@ func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*aut
 		ResourceType:      aws.String("auto-scaling-group"),
 	}
 
-	if tagIgnoredAutoscaling(t) {
+	if tagIgnoredAutoscaling(t, ignoreConfig) {
 		return nil, nil
 	}
 
@@ -304,14 +303,6 @@ func setToMapByKey(s *schema.Set, key string) map[string]interface{} {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredAutoscaling(t *autoscaling.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
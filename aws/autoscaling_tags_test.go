@@ -84,17 +84,17 @@ func TestDiffAutoscalingTags(t *testing.T) {
 	var resourceID = "sample"
 
 	for i, tc := range cases {
-		awsTagsOld, err := autoscalingTagsFromMap(tc.Old, resourceID)
+		awsTagsOld, err := autoscalingTagsFromMap(tc.Old, resourceID, nil)
 		if err != nil {
 			t.Fatalf("%d: unexpected error convertig old tags: %v", i, err)
 		}
 
-		awsTagsNew, err := autoscalingTagsFromMap(tc.New, resourceID)
+		awsTagsNew, err := autoscalingTagsFromMap(tc.New, resourceID, nil)
 		if err != nil {
 			t.Fatalf("%d: unexpected error convertig new tags: %v", i, err)
 		}
 
-		c, r, err := diffAutoscalingTags(awsTagsOld, awsTagsNew, resourceID)
+		c, r, err := diffAutoscalingTags(awsTagsOld, awsTagsNew, resourceID, nil)
 		if err != nil {
 			t.Fatalf("%d: unexpected error diff'ing tags: %v", i, err)
 		}
@@ -151,7 +151,7 @@ func TestIgnoringTagsAutoscaling(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredAutoscaling(tag) {
+		if !tagIgnoredAutoscaling(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -5,9 +5,72 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
 )
 
+// retryOnAwsCodesMaxWait is the longest delay between two attempts made by
+// retryOnAwsCode(s) when no max_retry_backoff is configured.
+const retryOnAwsCodesMaxWait = 10 * time.Second
+
+// awsRetryConfig holds the provider's retry settings: the maximum number of
+// retries, the maximum delay between two attempts and the extra error codes
+// to retry, keyed by the service names in awsServiceClients.
+type awsRetryConfig struct {
+	maxRetries int
+	maxBackoff time.Duration
+	errorCodes map[string][]string
+
+	// timeout is the eventual consistency window retryOnAwsCode(s) keep
+	// retrying for, however low maxRetries is.
+	timeout time.Duration
+}
+
+func newAwsRetryConfig(maxRetries int, maxBackoff time.Duration, errorCodes map[string][]string) *awsRetryConfig {
+	return &awsRetryConfig{
+		maxRetries: maxRetries,
+		maxBackoff: maxBackoff,
+		errorCodes: errorCodes,
+		timeout:    1 * time.Minute,
+	}
+}
+
+// awsRetryer is the request.Retryer attached to every AWS client. On top of
+// the SDK's default behaviour it caps the delay between attempts and retries
+// the extra error codes configured for the client's service.
+type awsRetryer struct {
+	client.DefaultRetryer
+
+	maxBackoff time.Duration
+	errorCodes []string
+}
+
+func newAwsRetryer(maxRetries int, maxBackoff time.Duration, errorCodes []string) *awsRetryer {
+	return &awsRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		maxBackoff:     maxBackoff,
+		errorCodes:     errorCodes,
+	}
+}
+
+// RetryRules returns the delay before retrying the request, capped at the
+// configured maximum backoff.
+func (r *awsRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.DefaultRetryer.RetryRules(req)
+	if r.maxBackoff > 0 && delay > r.maxBackoff {
+		return r.maxBackoff
+	}
+	return delay
+}
+
+// ShouldRetry returns true if the request should be retried.
+func (r *awsRetryer) ShouldRetry(req *request.Request) bool {
+	if req.Retryable == nil && isAWSErrCode(req.Error, r.errorCodes) {
+		return true
+	}
+	return r.DefaultRetryer.ShouldRetry(req)
+}
+
 func isAWSErr(err error, code string, message string) bool {
 	if err, ok := err.(awserr.Error); ok {
 		return err.Code() == code && strings.Contains(err.Message(), message)
@@ -15,40 +78,52 @@ func isAWSErr(err error, code string, message string) bool {
 	return false
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok && awsErr.Code() == code {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+func retryOnAwsCode(retryConfig *awsRetryConfig, service, code string, f func() (interface{}, error)) (interface{}, error) {
+	return retryOnAwsCodes(retryConfig, service, []string{code}, f)
+}
+
+// retryOnAwsCodes calls f until it returns no error or an error with a code
+// other than the given ones and the extra error codes configured for the
+// service. Attempts are made with an exponential backoff of at most the
+// provider's max_retry_backoff, for up to max_retries retries but at least
+// for a minute, which covers eventual consistency.
+func retryOnAwsCodes(retryConfig *awsRetryConfig, service string, codes []string, f func() (interface{}, error)) (interface{}, error) {
+	if retryConfig == nil {
+		retryConfig = newAwsRetryConfig(0, 0, nil)
+	}
+
+	codes = append(codes[:len(codes):len(codes)], retryConfig.errorCodes[service]...)
+	maxWait := retryConfig.maxBackoff
+	if maxWait <= 0 {
+		maxWait = retryOnAwsCodesMaxWait
+	}
+
+	deadline := time.Now().Add(retryConfig.timeout)
+	wait := 100 * time.Millisecond
+	for retries := 0; ; retries++ {
+		resp, err := f()
+		if err == nil || !isAWSErrCode(err, codes) {
+			return resp, err
+		}
+		if retries >= retryConfig.maxRetries && !time.Now().Before(deadline) {
+			return resp, err
 		}
-		return nil
-	})
-	return resp, err
-}
-
-func retryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
-	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-		resp, err = f()
-		if err != nil {
-			awsErr, ok := err.(awserr.Error)
-			if ok {
-				for _, code := range codes {
-					if awsErr.Code() == code {
-						return resource.RetryableError(err)
-					}
-				}
+
+		if wait > maxWait {
+			wait = maxWait
+		}
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+func isAWSErrCode(err error, codes []string) bool {
+	if err, ok := err.(awserr.Error); ok {
+		for _, code := range codes {
+			if err.Code() == code {
+				return true
 			}
-			return resource.NonRetryableError(err)
 		}
-		return nil
-	})
-	return resp, err
+	}
+	return false
 }
@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestAWSRetryer_ShouldRetry(t *testing.T) {
+	retryer := newAwsRetryer(3, 0, []string{"QueueDeletedRecently"})
+
+	cases := []struct {
+		StatusCode int
+		Error      error
+		Retryable  *bool
+		Expected   bool
+	}{
+		{400, awserr.New("QueueDeletedRecently", "queue deleted recently", nil), nil, true},
+		{400, awserr.New("AWS.SimpleQueueService.NonExistentQueue", "no such queue", nil), nil, false},
+		{400, awserr.New("Throttling", "rate exceeded", nil), nil, true},
+		{500, awserr.New("InternalFailure", "internal failure", nil), nil, true},
+		{400, awserr.New("QueueDeletedRecently", "queue deleted recently", nil), aws.Bool(false), false},
+	}
+
+	for i, tc := range cases {
+		r := &request.Request{
+			HTTPResponse: &http.Response{StatusCode: tc.StatusCode},
+			Error:        tc.Error,
+			Retryable:    tc.Retryable,
+		}
+		if got := retryer.ShouldRetry(r); got != tc.Expected {
+			t.Errorf("%d: expected ShouldRetry to return %t for %s, got %t", i, tc.Expected, tc.Error, got)
+		}
+	}
+}
+
+func TestAWSRetryer_RetryRules(t *testing.T) {
+	r := &request.Request{
+		HTTPResponse: &http.Response{StatusCode: 500},
+		RetryCount:   10,
+	}
+
+	if delay := newAwsRetryer(25, 0, nil).RetryRules(r); delay <= time.Second {
+		t.Errorf("Expected the SDK's exponential backoff without max backoff, got %s", delay)
+	}
+	if delay := newAwsRetryer(25, time.Second, nil).RetryRules(r); delay != time.Second {
+		t.Errorf("Expected a delay of 1s, got %s", delay)
+	}
+}
+
+func TestRetryOnAwsCodes(t *testing.T) {
+	retryConfig := &awsRetryConfig{
+		maxRetries: 2,
+		maxBackoff: time.Millisecond,
+		errorCodes: map[string][]string{
+			"s3": {"OperationAborted"},
+		},
+	}
+
+	cases := []struct {
+		Errors        []error
+		ExpectedCalls int
+		ExpectedError bool
+	}{
+		{[]error{nil}, 1, false},
+		{[]error{awserr.New("NoSuchBucket", "", nil), nil}, 2, false},
+		{[]error{awserr.New("NoSuchBucket", "", nil), awserr.New("NoSuchBucket", "", nil), awserr.New("NoSuchBucket", "", nil)}, 3, true},
+		{[]error{awserr.New("OperationAborted", "", nil), nil}, 2, false},
+		{[]error{awserr.New("AccessDenied", "", nil)}, 1, true},
+		{[]error{errors.New("connection reset")}, 1, true},
+	}
+
+	for i, tc := range cases {
+		calls := 0
+		_, err := retryOnAwsCodes(retryConfig, "s3", []string{"NotFound", "NoSuchBucket"}, func() (interface{}, error) {
+			err := tc.Errors[calls]
+			calls++
+			return nil, err
+		})
+
+		if calls != tc.ExpectedCalls {
+			t.Errorf("%d: expected %d calls, got %d", i, tc.ExpectedCalls, calls)
+		}
+		if (err != nil) != tc.ExpectedError {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestRetryOnAwsCodes_timeout(t *testing.T) {
+	retryConfig := &awsRetryConfig{
+		maxRetries: 0,
+		maxBackoff: time.Millisecond,
+		timeout:    50 * time.Millisecond,
+	}
+
+	start := time.Now()
+	calls := 0
+	_, err := retryOnAwsCode(retryConfig, "sqs", "QueueDeletedRecently", func() (interface{}, error) {
+		calls++
+		return nil, awserr.New("QueueDeletedRecently", "", nil)
+	})
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls < 2 || time.Since(start) < retryConfig.timeout {
+		t.Fatalf("expected retries for the whole eventual consistency window, got %d calls in %s", calls, time.Since(start))
+	}
+}
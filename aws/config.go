@@ -51,6 +51,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -90,34 +92,28 @@ type Config struct {
 	Profile       string
 	Token         string
 	Region        string
-	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	// MaxRetries and MaxRetryBackoff bound the retries of every request.
+	// RetryableErrorCodes holds extra error codes to retry, keyed by the
+	// service names in awsServiceClients.
+	MaxRetries          int
+	MaxRetryBackoff     time.Duration
+	RetryableErrorCodes map[string][]string
+
+	AssumeRoles               []*AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	Insecure                 bool
+	DefaultTags           map[string]interface{}
+	IgnoreTagsKeys        []string
+	IgnoreTagsKeyPrefixes []string
+
+	// Endpoints holds custom endpoint URLs keyed by the service names in
+	// awsServiceClients.
+	Endpoints map[string]string
+	Insecure  bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -127,6 +123,26 @@ type Config struct {
 	S3ForcePathStyle        bool
 }
 
+// AssumeRole holds the settings for a single role in the chain of roles
+// assumed, in order, before making API calls.
+type AssumeRole struct {
+	RoleARN         string
+	SessionName     string
+	ExternalID      string
+	Policy          string
+	DurationSeconds int
+}
+
+// AssumeRoleWithWebIdentity holds the settings for assuming a role with an
+// OpenID Connect token read from a file.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+	Policy               string
+	DurationSeconds      int
+}
+
 type AWSClient struct {
 	cfconn                *cloudformation.CloudFormation
 	cloudfrontconn        *cloudfront.CloudFront
@@ -166,6 +182,9 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	defaultTags           map[string]interface{}
+	ignoreTagsConfig      *ignoreTagsConfig
+	retryConfig           *awsRetryConfig
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -194,6 +213,8 @@ type AWSClient struct {
 	athenaconn            *athena.Athena
 	dxconn                *directconnect.DirectConnect
 	mediastoreconn        *mediastore.MediaStore
+	guarddutyconn         *guardduty.GuardDuty
+	glueconn              *glue.Glue
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -218,6 +239,101 @@ func (c *AWSClient) IsChinaCloud() bool {
 	return false
 }
 
+// awsServiceClients is the single table of every SDK client held by
+// AWSClient, keyed by the name of its override in the provider's endpoints
+// block. The endpoints schema and its hash are generated from this table,
+// so a client added here is endpoint-overridable without further changes.
+var awsServiceClients = []struct {
+	endpoint string
+	init     func(client *AWSClient, sess *session.Session)
+}{
+	{"acm", func(client *AWSClient, sess *session.Session) { client.acmconn = acm.New(sess) }},
+	{"apigateway", func(client *AWSClient, sess *session.Session) { client.apigateway = apigateway.New(sess) }},
+	{"applicationautoscaling", func(client *AWSClient, sess *session.Session) {
+		client.appautoscalingconn = applicationautoscaling.New(sess)
+	}},
+	{"athena", func(client *AWSClient, sess *session.Session) { client.athenaconn = athena.New(sess) }},
+	{"autoscaling", func(client *AWSClient, sess *session.Session) { client.autoscalingconn = autoscaling.New(sess) }},
+	{"batch", func(client *AWSClient, sess *session.Session) { client.batchconn = batch.New(sess) }},
+	{"cloudformation", func(client *AWSClient, sess *session.Session) { client.cfconn = cloudformation.New(sess) }},
+	{"cloudfront", func(client *AWSClient, sess *session.Session) { client.cloudfrontconn = cloudfront.New(sess) }},
+	{"cloudtrail", func(client *AWSClient, sess *session.Session) { client.cloudtrailconn = cloudtrail.New(sess) }},
+	{"cloudwatch", func(client *AWSClient, sess *session.Session) { client.cloudwatchconn = cloudwatch.New(sess) }},
+	{"cloudwatchevents", func(client *AWSClient, sess *session.Session) {
+		client.cloudwatcheventsconn = cloudwatchevents.New(sess)
+	}},
+	{"cloudwatchlogs", func(client *AWSClient, sess *session.Session) {
+		client.cloudwatchlogsconn = cloudwatchlogs.New(sess)
+	}},
+	{"codebuild", func(client *AWSClient, sess *session.Session) { client.codebuildconn = codebuild.New(sess) }},
+	{"codecommit", func(client *AWSClient, sess *session.Session) { client.codecommitconn = codecommit.New(sess) }},
+	{"codedeploy", func(client *AWSClient, sess *session.Session) { client.codedeployconn = codedeploy.New(sess) }},
+	{"codepipeline", func(client *AWSClient, sess *session.Session) { client.codepipelineconn = codepipeline.New(sess) }},
+	{"cognitoidentity", func(client *AWSClient, sess *session.Session) { client.cognitoconn = cognitoidentity.New(sess) }},
+	{"cognitoidp", func(client *AWSClient, sess *session.Session) {
+		client.cognitoidpconn = cognitoidentityprovider.New(sess)
+	}},
+	{"configservice", func(client *AWSClient, sess *session.Session) { client.configconn = configservice.New(sess) }},
+	{"devicefarm", func(client *AWSClient, sess *session.Session) { client.devicefarmconn = devicefarm.New(sess) }},
+	{"directconnect", func(client *AWSClient, sess *session.Session) { client.dxconn = directconnect.New(sess) }},
+	{"directoryservice", func(client *AWSClient, sess *session.Session) { client.dsconn = directoryservice.New(sess) }},
+	{"dms", func(client *AWSClient, sess *session.Session) { client.dmsconn = databasemigrationservice.New(sess) }},
+	{"dynamodb", func(client *AWSClient, sess *session.Session) { client.dynamodbconn = dynamodb.New(sess) }},
+	{"ec2", func(client *AWSClient, sess *session.Session) { client.ec2conn = ec2.New(sess) }},
+	{"ecr", func(client *AWSClient, sess *session.Session) { client.ecrconn = ecr.New(sess) }},
+	{"ecs", func(client *AWSClient, sess *session.Session) { client.ecsconn = ecs.New(sess) }},
+	{"efs", func(client *AWSClient, sess *session.Session) { client.efsconn = efs.New(sess) }},
+	{"elasticache", func(client *AWSClient, sess *session.Session) { client.elasticacheconn = elasticache.New(sess) }},
+	{"elasticbeanstalk", func(client *AWSClient, sess *session.Session) {
+		client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
+	}},
+	{"elastictranscoder", func(client *AWSClient, sess *session.Session) {
+		client.elastictranscoderconn = elastictranscoder.New(sess)
+	}},
+	// Classic and v2 load balancers share the elb endpoint
+	{"elb", func(client *AWSClient, sess *session.Session) {
+		client.elbconn = elb.New(sess)
+		client.elbv2conn = elbv2.New(sess)
+	}},
+	{"emr", func(client *AWSClient, sess *session.Session) { client.emrconn = emr.New(sess) }},
+	{"es", func(client *AWSClient, sess *session.Session) { client.esconn = elasticsearch.New(sess) }},
+	{"firehose", func(client *AWSClient, sess *session.Session) { client.firehoseconn = firehose.New(sess) }},
+	{"glacier", func(client *AWSClient, sess *session.Session) { client.glacierconn = glacier.New(sess) }},
+	{"glue", func(client *AWSClient, sess *session.Session) { client.glueconn = glue.New(sess) }},
+	{"guardduty", func(client *AWSClient, sess *session.Session) { client.guarddutyconn = guardduty.New(sess) }},
+	{"iam", func(client *AWSClient, sess *session.Session) { client.iamconn = iam.New(sess) }},
+	{"inspector", func(client *AWSClient, sess *session.Session) { client.inspectorconn = inspector.New(sess) }},
+	{"iot", func(client *AWSClient, sess *session.Session) { client.iotconn = iot.New(sess) }},
+	{"kinesis", func(client *AWSClient, sess *session.Session) { client.kinesisconn = kinesis.New(sess) }},
+	{"kms", func(client *AWSClient, sess *session.Session) { client.kmsconn = kms.New(sess) }},
+	{"lambda", func(client *AWSClient, sess *session.Session) { client.lambdaconn = lambda.New(sess) }},
+	{"lightsail", func(client *AWSClient, sess *session.Session) { client.lightsailconn = lightsail.New(sess) }},
+	{"mediastore", func(client *AWSClient, sess *session.Session) { client.mediastoreconn = mediastore.New(sess) }},
+	{"mq", func(client *AWSClient, sess *session.Session) { client.mqconn = mq.New(sess) }},
+	{"opsworks", func(client *AWSClient, sess *session.Session) { client.opsworksconn = opsworks.New(sess) }},
+	{"rds", func(client *AWSClient, sess *session.Session) { client.rdsconn = rds.New(sess) }},
+	{"redshift", func(client *AWSClient, sess *session.Session) { client.redshiftconn = redshift.New(sess) }},
+	// This restriction should only be used for Route53 sessions.
+	// Other resources that have restrictions should allow the API to fail, rather
+	// than Terraform abstracting the region for the user. This can lead to breaking
+	// changes if that resource is ever opened up to more regions.
+	{"route53", func(client *AWSClient, sess *session.Session) {
+		client.r53conn = route53.New(sess.Copy(&aws.Config{Region: aws.String("us-east-1")}))
+	}},
+	{"s3", func(client *AWSClient, sess *session.Session) { client.s3conn = s3.New(sess) }},
+	{"sdb", func(client *AWSClient, sess *session.Session) { client.simpledbconn = simpledb.New(sess) }},
+	{"servicecatalog", func(client *AWSClient, sess *session.Session) { client.scconn = servicecatalog.New(sess) }},
+	{"servicediscovery", func(client *AWSClient, sess *session.Session) { client.sdconn = servicediscovery.New(sess) }},
+	{"ses", func(client *AWSClient, sess *session.Session) { client.sesConn = ses.New(sess) }},
+	{"sfn", func(client *AWSClient, sess *session.Session) { client.sfnconn = sfn.New(sess) }},
+	{"sns", func(client *AWSClient, sess *session.Session) { client.snsconn = sns.New(sess) }},
+	{"sqs", func(client *AWSClient, sess *session.Session) { client.sqsconn = sqs.New(sess) }},
+	{"ssm", func(client *AWSClient, sess *session.Session) { client.ssmconn = ssm.New(sess) }},
+	{"sts", func(client *AWSClient, sess *session.Session) { client.stsconn = sts.New(sess) }},
+	{"waf", func(client *AWSClient, sess *session.Session) { client.wafconn = waf.New(sess) }},
+	{"wafregional", func(client *AWSClient, sess *session.Session) { client.wafregionalconn = wafregional.New(sess) }},
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -236,6 +352,9 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsConfig = newIgnoreTagsConfig(c.IgnoreTagsKeys, c.IgnoreTagsKeyPrefixes)
+	client.retryConfig = newAwsRetryConfig(c.MaxRetries, c.MaxRetryBackoff, c.RetryableErrorCodes)
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -245,7 +364,7 @@ func (c *Config) Client() (interface{}, error) {
 
 	// define the AWS Session options
 	// Credentials or Profile will be set in the Options below
-	// MaxRetries may be set once we validate credentials
+	// Retries are set per service client once the session is created
 	var opt = session.Options{
 		Config: aws.Config{
 			Region:           aws.String(c.Region),
@@ -291,7 +410,7 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	// create base session with no retries. MaxRetries will be set later
+	// create base session with no retries. Service clients get their retryer below
 	sess, err := session.NewSessionWithOptions(opt)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
@@ -308,43 +427,20 @@ func (c *Config) Client() (interface{}, error) {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
 	}
 
-	// if the desired number of retries is non-zero, update the session
-	if c.MaxRetries > 0 {
-		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	// Every service can have its endpoint overridden via the endpoints block
+	// and gets a retryer honouring the provider's retry settings
+	for _, sc := range awsServiceClients {
+		endpoint := c.Endpoints[sc.endpoint]
+		if endpoint != "" {
+			log.Printf("[INFO] Setting custom %s endpoint: %q", sc.endpoint, endpoint)
+		}
+		if codes := c.RetryableErrorCodes[sc.endpoint]; len(codes) > 0 {
+			log.Printf("[INFO] Retrying %s error codes: %q", sc.endpoint, codes)
+		}
+		retryer := newAwsRetryer(client.retryConfig.maxRetries, client.retryConfig.maxBackoff, client.retryConfig.errorCodes[sc.endpoint])
+		sc.init(&client, sess.Copy(request.WithRetryer(&aws.Config{Endpoint: aws.String(endpoint)}, retryer)))
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
-
-	// Some services have user-configurable endpoints
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
-
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(sess)
-
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
 		if err != nil {
@@ -365,8 +461,6 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, authErr
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
 		if err != nil {
@@ -378,65 +472,6 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.acmconn = acm.New(sess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(sess)
-	client.ecsconn = ecs.New(sess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lightsailconn = lightsail.New(sess)
-	client.mqconn = mq.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
 		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
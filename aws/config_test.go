@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestGetSupportedEC2Platforms(t *testing.T) {
@@ -41,6 +43,109 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigClient_endpoints(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+		Endpoints:               make(map[string]string),
+	}
+	for _, sc := range awsServiceClients {
+		c.Endpoints[sc.endpoint] = fmt.Sprintf("http://%s.localhost", sc.endpoint)
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error configuring client: %s", err)
+	}
+
+	// Every SDK client held by AWSClient has to use the overridden endpoint
+	v := reflect.ValueOf(raw).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		sdkClient := field.Elem().FieldByName("Client")
+		if !sdkClient.IsValid() {
+			continue
+		}
+		if sdkClient.IsNil() {
+			t.Errorf("AWSClient.%s was not initialized", v.Type().Field(i).Name)
+			continue
+		}
+
+		endpoint := sdkClient.Elem().FieldByName("ClientInfo").FieldByName("Endpoint").String()
+		if !strings.HasSuffix(endpoint, ".localhost") {
+			t.Errorf("AWSClient.%s uses endpoint %q, expected an overridden endpoint",
+				v.Type().Field(i).Name, endpoint)
+		}
+	}
+}
+
+func TestEndpointsSchema(t *testing.T) {
+	endpoints := endpointsSchema().Elem.(*schema.Resource).Schema
+	if len(endpoints) != len(awsServiceClients) {
+		t.Fatalf("Expected %d endpoints, got %d: duplicate service names in awsServiceClients?",
+			len(awsServiceClients), len(endpoints))
+	}
+
+	for _, sc := range awsServiceClients {
+		if _, ok := endpoints[sc.endpoint]; !ok {
+			t.Errorf("Expected endpoint %q in schema", sc.endpoint)
+		}
+	}
+}
+
+func TestConfigClient_retryer(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		MaxRetries:              5,
+		MaxRetryBackoff:         30 * time.Second,
+		RetryableErrorCodes:     map[string][]string{"sqs": {"QueueDeletedRecently"}},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error configuring client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	retryer, ok := client.sqsconn.Retryer.(*awsRetryer)
+	if !ok {
+		t.Fatalf("Expected SQS client to use an awsRetryer, got %T", client.sqsconn.Retryer)
+	}
+	if retryer.MaxRetries() != 5 {
+		t.Errorf("Expected 5 max retries, got %d", retryer.MaxRetries())
+	}
+	if retryer.maxBackoff != 30*time.Second {
+		t.Errorf("Expected max backoff of 30s, got %s", retryer.maxBackoff)
+	}
+	if !reflect.DeepEqual(retryer.errorCodes, []string{"QueueDeletedRecently"}) {
+		t.Errorf("Expected SQS error codes to be retried, got %q", retryer.errorCodes)
+	}
+
+	if retryer, ok := client.s3conn.Retryer.(*awsRetryer); !ok || len(retryer.errorCodes) > 0 {
+		t.Errorf("Expected S3 client to use an awsRetryer without extra error codes, got %#v", client.s3conn.Retryer)
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
@@ -249,7 +249,7 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] aws_ami - Single AMI found: %s", *image.ImageId)
-	return amiDescriptionAttributes(d, image)
+	return amiDescriptionAttributes(d, image, meta.(*AWSClient).ignoreTagsConfig)
 }
 
 // Returns the most recent AMI out of a slice of images.
@@ -258,7 +258,7 @@ func mostRecentAmi(images []*ec2.Image) *ec2.Image {
 }
 
 // populate the numerous fields that the image description returns.
-func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
+func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image, ignoreConfig *ignoreTagsConfig) error {
 	// Simple attributes first
 	d.SetId(*image.ImageId)
 	d.Set("architecture", image.Architecture)
@@ -305,7 +305,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 	if err := d.Set("state_reason", amiStateReason(image.StateReason)); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsToMap(image.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(image.Tags, ignoreConfig)); err != nil {
 		return err
 	}
 	return nil
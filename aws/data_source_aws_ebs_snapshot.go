@@ -135,14 +135,14 @@ func dataSourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	//Single Snapshot found so set to state
-	return snapshotDescriptionAttributes(d, snapshot)
+	return snapshotDescriptionAttributes(d, snapshot, meta.(*AWSClient).ignoreTagsConfig)
 }
 
 func mostRecentSnapshot(snapshots []*ec2.Snapshot) *ec2.Snapshot {
 	return sortSnapshots(snapshots)[0]
 }
 
-func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot) error {
+func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot, ignoreConfig *ignoreTagsConfig) error {
 	d.SetId(*snapshot.SnapshotId)
 	d.Set("snapshot_id", snapshot.SnapshotId)
 	d.Set("volume_id", snapshot.VolumeId)
@@ -155,7 +155,7 @@ func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapsho
 	d.Set("owner_id", snapshot.OwnerId)
 	d.Set("owner_alias", snapshot.OwnerAlias)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(snapshot.Tags, ignoreConfig)); err != nil {
 		return err
 	}
 
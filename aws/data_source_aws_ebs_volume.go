@@ -104,7 +104,7 @@ func dataSourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] aws_ebs_volume - Single Volume found: %s", *volume.VolumeId)
-	return volumeDescriptionAttributes(d, meta.(*AWSClient), volume)
+	return volumeDescriptionAttributes(d, meta.(*AWSClient), volume, meta.(*AWSClient).ignoreTagsConfig)
 }
 
 type volumeSort []*ec2.Volume
@@ -123,7 +123,7 @@ func mostRecentVolume(volumes []*ec2.Volume) *ec2.Volume {
 	return sortedVolumes[len(sortedVolumes)-1]
 }
 
-func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) error {
+func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume, ignoreConfig *ignoreTagsConfig) error {
 	d.SetId(*volume.VolumeId)
 	d.Set("volume_id", volume.VolumeId)
 
@@ -144,7 +144,7 @@ func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volu
 	d.Set("snapshot_id", volume.SnapshotId)
 	d.Set("volume_type", volume.VolumeType)
 
-	if err := d.Set("tags", tagsToMap(volume.Tags)); err != nil {
+	if err := d.Set("tags", tagsToMap(volume.Tags, ignoreConfig)); err != nil {
 		return err
 	}
 
@@ -30,9 +30,19 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"override_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+			},
 			"statement": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"sid": {
@@ -94,6 +104,16 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 }
 
 func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	mergedDoc := &IAMPolicyDoc{}
+
+	// populate mergedDoc directly with any source_json
+	if sourceJson, hasSourceJson := d.GetOk("source_json"); hasSourceJson {
+		if err := dataSourceAwsIamPolicyDocumentDecode(sourceJson.(string), mergedDoc); err != nil {
+			return fmt.Errorf("Error reading source_json: %s", err)
+		}
+	}
+
+	// process the current document
 	doc := &IAMPolicyDoc{
 		Version: "2012-10-17",
 	}
@@ -148,7 +168,24 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 		stmts[i] = stmt
 	}
 
-	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err := doc.CheckDuplicateSids(); err != nil {
+		return err
+	}
+
+	// merge our current document into mergedDoc
+	mergedDoc.Merge(doc)
+
+	// merge in override_json
+	if overrideJson, hasOverrideJson := d.GetOk("override_json"); hasOverrideJson {
+		overrideDoc := &IAMPolicyDoc{}
+		if err := dataSourceAwsIamPolicyDocumentDecode(overrideJson.(string), overrideDoc); err != nil {
+			return fmt.Errorf("Error reading override_json: %s", err)
+		}
+
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
 		return err
@@ -161,6 +198,13 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func dataSourceAwsIamPolicyDocumentDecode(in string, doc *IAMPolicyDoc) error {
+	if err := json.Unmarshal([]byte(in), doc); err != nil {
+		return err
+	}
+	return doc.CheckDuplicateSids()
+}
+
 func dataSourceAwsIamPolicyDocumentReplaceVarsInList(in interface{}) interface{} {
 	switch v := in.(type) {
 	case string:
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -28,6 +29,73 @@ func TestAccAWSDataSourceIAMPolicyDocument_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_source(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_source", "json",
+						testAccAWSIAMPolicyDocumentSourceExpectedJSON,
+					),
+					testAccCheckStateValue("data.aws_iam_policy_document.test_source_blank", "json",
+						testAccAWSIAMPolicyDocumentSourceBlankExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_sourceConflicting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentSourceConflictingConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_source_conflicting", "json",
+						testAccAWSIAMPolicyDocumentSourceConflictingExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_override(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMPolicyDocumentOverrideConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStateValue("data.aws_iam_policy_document.test_override", "json",
+						testAccAWSIAMPolicyDocumentOverrideExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceIAMPolicyDocument_duplicateSid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentDuplicateSidConfig,
+				ExpectError: regexp.MustCompile(`Found duplicate sid`),
+			},
+		},
+	})
+}
+
 func testAccCheckStateValue(id, name, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -187,3 +255,215 @@ var testAccAWSIAMPolicyDocumentExpectedJSON = `{
     }
   ]
 }`
+
+var testAccAWSIAMPolicyDocumentSourceConfig = testAccAWSIAMPolicyDocumentConfig + `
+data "aws_iam_policy_document" "test_source" {
+    source_json = "${data.aws_iam_policy_document.test.json}"
+
+    statement {
+        sid       = "SourceJSONTest1"
+        actions   = ["*"]
+        resources = ["*"]
+    }
+}
+
+data "aws_iam_policy_document" "test_source_blank" {
+    source_json = ""
+
+    statement {
+        sid       = "SourceJSONTest2"
+        actions   = ["*"]
+        resources = ["*"]
+    }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourceExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Id": "policy_id",
+  "Statement": [
+    {
+      "Sid": "1",
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListAllMyBuckets",
+        "s3:GetBucketLocation"
+      ],
+      "Resource": "arn:aws:s3:::*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::foo",
+      "NotPrincipal": {
+        "AWS": "arn:blahblah:example"
+      },
+      "Condition": {
+        "StringLike": {
+          "s3:prefix": [
+            "home/${aws:username}/",
+            "home/"
+          ]
+        }
+      }
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": [
+        "arn:aws:s3:::foo/home/${aws:username}/*",
+        "arn:aws:s3:::foo/home/${aws:username}"
+      ],
+      "Principal": {
+        "AWS": "arn:blahblah:example"
+      }
+    },
+    {
+      "Sid": "",
+      "Effect": "Deny",
+      "NotAction": "s3:*",
+      "NotResource": "arn:aws:s3:::*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "kinesis:*",
+      "Principal": "*"
+    },
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "firehose:*",
+      "Principal": "*"
+    },
+    {
+      "Sid": "SourceJSONTest1",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourceBlankExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "SourceJSONTest2",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentSourceConflictingConfig = `
+data "aws_iam_policy_document" "test_source" {
+    statement {
+        sid       = "SourceJSONTestConflicting"
+        actions   = ["iam:*"]
+        resources = ["*"]
+    }
+
+    statement {
+        sid       = "SourceJSONTestKept"
+        actions   = ["ec2:*"]
+        resources = ["*"]
+    }
+}
+
+data "aws_iam_policy_document" "test_source_conflicting" {
+    source_json = "${data.aws_iam_policy_document.test_source.json}"
+
+    statement {
+        sid       = "SourceJSONTestConflicting"
+        actions   = ["*"]
+        resources = ["*"]
+    }
+}
+`
+
+var testAccAWSIAMPolicyDocumentSourceConflictingExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "SourceJSONTestConflicting",
+      "Effect": "Allow",
+      "Action": "*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "SourceJSONTestKept",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentOverrideConfig = `
+data "aws_iam_policy_document" "override" {
+    statement {
+        sid = "SidToOverwrite"
+
+        actions   = ["s3:*"]
+        resources = ["*"]
+    }
+}
+
+data "aws_iam_policy_document" "test_override" {
+    override_json = "${data.aws_iam_policy_document.override.json}"
+
+    statement {
+        actions   = ["ec2:*"]
+        resources = ["*"]
+    }
+
+    statement {
+        sid = "SidToOverwrite"
+
+        actions = ["s3:*"]
+        resources = [
+            "arn:aws:s3:::somebucket",
+            "arn:aws:s3:::somebucket/*",
+        ]
+    }
+}
+`
+
+var testAccAWSIAMPolicyDocumentOverrideExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Action": "ec2:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "SidToOverwrite",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    }
+  ]
+}`
+
+var testAccAWSIAMPolicyDocumentDuplicateSidConfig = `
+data "aws_iam_policy_document" "test" {
+    statement {
+        sid    = "1"
+        effect = "Allow"
+        actions = ["ec2:DescribeAccountAttributes"]
+        resources = ["*"]
+    }
+    statement {
+        sid    = "1"
+        effect = "Allow"
+        actions = ["s3:GetObject"]
+        resources = ["*"]
+    }
+}
+`
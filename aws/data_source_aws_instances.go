@@ -61,7 +61,7 @@ func dataSourceAwsInstancesRead(d *schema.ResourceData, meta interface{}) error
 	}
 	if tagsOk {
 		params.Filters = append(params.Filters, buildEC2TagFilterList(
-			tagsFromMap(tags.(map[string]interface{})),
+			tagsFromMap(tags.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 		)...)
 	}
 
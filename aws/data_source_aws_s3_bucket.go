@@ -66,14 +66,14 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("arn", fmt.Sprintf("arn:%s:s3:::%s", meta.(*AWSClient).partition, bucket))
 	d.Set("bucket_domain_name", bucketDomainName(bucket))
 
-	if err := bucketLocation(d, bucket, conn); err != nil {
+	if err := bucketLocation(d, bucket, conn, meta.(*AWSClient).retryConfig); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func bucketLocation(d *schema.ResourceData, bucket string, conn *s3.S3) error {
+func bucketLocation(d *schema.ResourceData, bucket string, conn *s3.S3, retryConfig *awsRetryConfig) error {
 	location, err := conn.GetBucketLocation(
 		&s3.GetBucketLocationInput{
 			Bucket: aws.String(bucket),
@@ -211,7 +211,7 @@ func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapS3(tagResp.TagSet))
+	d.Set("tags", tagsToMapS3(tagResp.TagSet, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -42,7 +42,7 @@ func dataSourceAwsSubnetIDsRead(d *schema.ResourceData, meta interface{}) error
 	)
 
 	req.Filters = append(req.Filters, buildEC2TagFilterList(
-		tagsFromMap(d.Get("tags").(map[string]interface{})),
+		tagsFromMap(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 	)...)
 
 	log.Printf("[DEBUG] DescribeSubnets %s\n", req)
@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"sort"
+	"strconv"
 )
 
 type IAMPolicyDoc struct {
@@ -37,6 +40,102 @@ type IAMPolicyStatementCondition struct {
 type IAMPolicyStatementPrincipalSet []IAMPolicyStatementPrincipal
 type IAMPolicyStatementConditionSet []IAMPolicyStatementCondition
 
+// Merge merges the statements of newDoc into the document. Statements with
+// a Sid replace the existing statement with the same Sid in place, all other
+// statements are appended in order.
+func (self *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		self.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > self.Version {
+		self.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			self.Statements = append(self.Statements, newStatement)
+			continue
+		}
+
+		seen := false
+		for i, existingStatement := range self.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				self.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			self.Statements = append(self.Statements, newStatement)
+		}
+	}
+}
+
+func (self *IAMPolicyDoc) MarshalJSON() ([]byte, error) {
+	type iamPolicyDoc IAMPolicyDoc
+	doc := iamPolicyDoc(*self)
+
+	// IAM rejects a null Statement, emit an empty list instead
+	if doc.Statements == nil {
+		doc.Statements = []*IAMPolicyStatement{}
+	}
+
+	return json.Marshal(&doc)
+}
+
+func (self *IAMPolicyDoc) UnmarshalJSON(b []byte) error {
+	type iamPolicyDoc IAMPolicyDoc
+	var raw struct {
+		iamPolicyDoc
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	doc := IAMPolicyDoc(raw.iamPolicyDoc)
+	doc.Statements = nil
+
+	// IAM accepts a single statement object as well as a list of statements
+	statement := bytes.TrimSpace(raw.Statement)
+	switch {
+	case len(statement) == 0 || bytes.Equal(statement, []byte("null")):
+	case statement[0] == '{':
+		stmt := &IAMPolicyStatement{}
+		if err := json.Unmarshal(statement, stmt); err != nil {
+			return err
+		}
+		doc.Statements = []*IAMPolicyStatement{stmt}
+	default:
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return err
+		}
+	}
+
+	*self = doc
+	return nil
+}
+
+// CheckDuplicateSids returns an error if two statements of the document
+// share the same non-empty Sid.
+func (self *IAMPolicyDoc) CheckDuplicateSids() error {
+	sids := make(map[string]struct{}, len(self.Statements))
+	for _, statement := range self.Statements {
+		if len(statement.Sid) == 0 {
+			continue
+		}
+		if _, ok := sids[statement.Sid]; ok {
+			return fmt.Errorf("Found duplicate sid (%s). Either remove the sid or ensure the sid is unique across all statements.", statement.Sid)
+		}
+		sids[statement.Sid] = struct{}{}
+	}
+	return nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -75,6 +174,33 @@ func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&raw)
 }
 
+func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementPrincipalSet
+
+	var data interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, IAMPolicyStatementPrincipal{Type: "*", Identifiers: []string{t}})
+	case map[string]interface{}:
+		for key, value := range t {
+			identifiers, err := iamPolicyDecodeJsonStringList(value)
+			if err != nil {
+				return fmt.Errorf("Unsupported data type for IAMPolicyStatementPrincipalSet.Identifiers: %s", err)
+			}
+			out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: identifiers})
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
 func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]map[string]interface{}{}
 
@@ -99,6 +225,61 @@ func (cs IAMPolicyStatementConditionSet) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&raw)
 }
 
+func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
+	var out IAMPolicyStatementConditionSet
+
+	var data map[string]map[string]interface{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for test, variables := range data {
+		for variable, value := range variables {
+			values, err := iamPolicyDecodeJsonStringList(value)
+			if err != nil {
+				return fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet.Values: %s", err)
+			}
+			out = append(out, IAMPolicyStatementCondition{Test: test, Variable: variable, Values: values})
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// iamPolicyDecodeJsonStringList converts a decoded JSON value or list of
+// values into the string or []string representation used by the model.
+// IAM treats scalar values such as booleans and numbers as strings.
+func iamPolicyDecodeJsonStringList(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case []interface{}:
+		ret := make([]string, len(t))
+		for i, item := range t {
+			s, err := iamPolicyDecodeJsonString(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = s
+		}
+		return ret, nil
+	default:
+		return iamPolicyDecodeJsonString(t)
+	}
+}
+
+func iamPolicyDecodeJsonString(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("%T", t)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
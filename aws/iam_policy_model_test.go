@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIAMPolicyDocUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		Json          string
+		NumStatements int
+	}{
+		{
+			Json:          `{"Version":"2012-10-17","Statement":[{"Sid":"a","Effect":"Allow","Action":"s3:*","Resource":"*"},{"Sid":"b","Effect":"Deny","Action":"ec2:*","Resource":"*"}]}`,
+			NumStatements: 2,
+		},
+		{
+			Json:          `{"Version":"2012-10-17","Statement":{"Sid":"a","Effect":"Allow","Action":"s3:*","Resource":"*"}}`,
+			NumStatements: 1,
+		},
+		{
+			Json:          `{"Version":"2012-10-17"}`,
+			NumStatements: 0,
+		},
+	}
+
+	for i, tc := range cases {
+		doc := &IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(tc.Json), doc); err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if doc.Version != "2012-10-17" {
+			t.Fatalf("%d: expected Version to be decoded, got %q", i, doc.Version)
+		}
+		if len(doc.Statements) != tc.NumStatements {
+			t.Fatalf("%d: expected %d statements, got %d", i, tc.NumStatements, len(doc.Statements))
+		}
+		if tc.NumStatements > 0 && doc.Statements[0].Sid != "a" {
+			t.Fatalf("%d: expected first statement Sid to be %q, got %q", i, "a", doc.Statements[0].Sid)
+		}
+	}
+}
+
+func TestIAMPolicyDocMarshalJSON_noStatements(t *testing.T) {
+	b, err := json.Marshal(&IAMPolicyDoc{Version: "2012-10-17"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := `{"Version":"2012-10-17","Statement":[]}`
+	if string(b) != expected {
+		t.Fatalf("expected %s, got %s", expected, string(b))
+	}
+}
@@ -0,0 +1,286 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// awsOfflineServer is an in-process stand-in for the AWS APIs, used to run
+// resource CRUD cycles without an AWS account. Every service in
+// awsServiceClients is served under its own path prefix (e.g. /sqs), and
+// providerConfig points the provider's endpoints block at those prefixes.
+//
+// Responses are scripted per service operation with awsOfflineOperation.
+// Requests that match no scripted operation fail the test.
+type awsOfflineServer struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu         sync.Mutex
+	operations []*awsOfflineOperation
+	calls      map[string]int
+}
+
+// awsOfflineOperation scripts the response to a single service operation.
+type awsOfflineOperation struct {
+	// Service is the key of the endpoints block the request is sent to,
+	// e.g. "sqs" or "s3".
+	Service string
+
+	// Operation identifies the request. It is the Action parameter for
+	// Query APIs (e.g. "CreateQueue"), the X-Amz-Target operation for JSON
+	// APIs, or the method and path for REST APIs, followed by any
+	// value-less subresource query parameters (e.g. "GET /bucket?tagging").
+	Operation string
+
+	// Params, if set, must all be present in the request's query string or
+	// form-encoded body with the given values.
+	Params map[string]string
+
+	// After, if set, restricts this response to requests made once the
+	// named operation of the same service has been called since this
+	// operation was scripted, e.g. to answer reads with a not found error
+	// once the resource has been deleted.
+	After string
+
+	// Response is returned to matching requests.
+	Response *awsMockResponse
+
+	// afterCalls is the number of calls to After when this operation was
+	// scripted.
+	afterCalls int
+}
+
+// newAwsOfflineServer starts a server answering with the given operations.
+func newAwsOfflineServer(t *testing.T, operations ...*awsOfflineOperation) *awsOfflineServer {
+	s := &awsOfflineServer{
+		t:     t,
+		calls: make(map[string]int),
+	}
+	s.Add(operations...)
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Add scripts further operations, typically from the PreConfig of a test
+// step. When several operations match a request the last one added wins,
+// so operations added later override earlier ones.
+func (s *awsOfflineServer) Add(operations ...*awsOfflineOperation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, o := range operations {
+		if o.After != "" {
+			o.afterCalls = s.calls[o.Service+" "+o.After]
+		}
+		s.operations = append(s.operations, o)
+	}
+}
+
+func (s *awsOfflineServer) Close() {
+	s.server.Close()
+}
+
+// Endpoint returns the URL the given service is served at.
+func (s *awsOfflineServer) Endpoint(service string) string {
+	return fmt.Sprintf("%s/%s", s.server.URL, service)
+}
+
+// Calls returns the number of requests made to the given service operation.
+func (s *awsOfflineServer) Calls(service, operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[service+" "+operation]
+}
+
+// providerConfig returns a provider block routing every service to the
+// server with static credentials and all account lookups skipped.
+func (s *awsOfflineServer) providerConfig() string {
+	var buf bytes.Buffer
+	buf.WriteString(`
+provider "aws" {
+  region                      = "us-east-1"
+  access_key                  = "mock_access_key"
+  secret_key                  = "mock_secret_key"
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+  skip_requesting_account_id  = true
+  s3_force_path_style         = true
+  max_retries                 = 1
+
+  endpoints {
+`)
+	for _, sc := range awsServiceClients {
+		buf.WriteString(fmt.Sprintf("    %s = %q\n", sc.endpoint, s.Endpoint(sc.endpoint)))
+	}
+	buf.WriteString("  }\n}\n")
+	return buf.String()
+}
+
+func (s *awsOfflineServer) handle(w http.ResponseWriter, r *http.Request) {
+	service, path := r.URL.Path, "/"
+	service = strings.TrimPrefix(service, "/")
+	if i := strings.Index(service, "/"); i >= 0 {
+		service, path = service[:i], service[i:]
+	}
+
+	if err := r.ParseForm(); err != nil {
+		s.t.Errorf("Error parsing %s request to %q: %s", service, r.URL, err)
+		w.WriteHeader(400)
+		return
+	}
+
+	operation := awsOfflineOperationName(r, path)
+	log.Printf("[DEBUG] Offline %s API received %s %s (%s)", service, r.Method, r.URL, operation)
+
+	s.mu.Lock()
+	var match *awsOfflineOperation
+	for _, o := range s.operations {
+		if o.Service != service || o.Operation != operation {
+			continue
+		}
+		if o.After != "" && s.calls[service+" "+o.After] <= o.afterCalls {
+			continue
+		}
+		if !awsOfflineParamsMatch(o.Params, r) {
+			continue
+		}
+		match = o
+	}
+	s.calls[service+" "+operation]++
+	s.mu.Unlock()
+
+	if match == nil {
+		s.t.Errorf("Unexpected %s API request %s %s (%s), params: %v", service, r.Method, r.URL, operation, r.Form)
+		match = &awsOfflineOperation{
+			Response: awsOfflineQueryError(400, "UnscriptedOperation", "No response scripted for "+operation),
+		}
+	}
+
+	contentType := match.Response.ContentType
+	if contentType == "" {
+		contentType = "text/xml"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-Requestid", "1b206dd1-f9a8-11e5-becf-051c60f11c4a")
+	w.Header().Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	w.WriteHeader(match.Response.StatusCode)
+
+	fmt.Fprint(w, match.Response.Body)
+}
+
+// awsOfflineOperationName derives the operation a request is made for,
+// see awsOfflineOperation.Operation.
+func awsOfflineOperationName(r *http.Request, path string) string {
+	if action := r.Form.Get("Action"); action != "" {
+		return action
+	}
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		return target[strings.LastIndex(target, ".")+1:]
+	}
+
+	var subresources []string
+	for k, v := range r.URL.Query() {
+		if len(v) == 1 && v[0] == "" {
+			subresources = append(subresources, k)
+		}
+	}
+	sort.Strings(subresources)
+
+	operation := r.Method + " " + path
+	if len(subresources) > 0 {
+		operation += "?" + strings.Join(subresources, "&")
+	}
+	return operation
+}
+
+func awsOfflineParamsMatch(params map[string]string, r *http.Request) bool {
+	for k, v := range params {
+		if r.Form.Get(k) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// awsOfflineQueryResponse returns the successful response of a Query API
+// action such as SQS, SNS or IAM, wrapping the given result elements.
+func awsOfflineQueryResponse(action, result string) *awsMockResponse {
+	var body bytes.Buffer
+	body.WriteString(fmt.Sprintf("<%sResponse>\n", action))
+	if result != "" {
+		body.WriteString(fmt.Sprintf("  <%sResult>\n%s\n  </%sResult>\n", action, result, action))
+	}
+	body.WriteString("  <ResponseMetadata>\n    <RequestId>1b206dd1-f9a8-11e5-becf-051c60f11c4a</RequestId>\n  </ResponseMetadata>\n")
+	body.WriteString(fmt.Sprintf("</%sResponse>", action))
+	return &awsMockResponse{200, body.String(), "text/xml"}
+}
+
+// awsOfflineQueryError returns the error response of a Query API such as
+// SQS, SNS or IAM.
+func awsOfflineQueryError(statusCode int, code, message string) *awsMockResponse {
+	return &awsMockResponse{statusCode, fmt.Sprintf(`<ErrorResponse>
+  <Error>
+    <Type>Sender</Type>
+    <Code>%s</Code>
+    <Message>%s</Message>
+  </Error>
+  <RequestId>1b206dd1-f9a8-11e5-becf-051c60f11c4a</RequestId>
+</ErrorResponse>`, code, message), "text/xml"}
+}
+
+// awsOfflineRestXmlError returns the error response of a REST-XML API such
+// as S3.
+func awsOfflineRestXmlError(statusCode int, code, message string) *awsMockResponse {
+	return &awsMockResponse{statusCode, fmt.Sprintf(`<Error>
+  <Code>%s</Code>
+  <Message>%s</Message>
+  <RequestId>1b206dd1-f9a8-11e5-becf-051c60f11c4a</RequestId>
+</Error>`, code, message), "application/xml"}
+}
+
+func TestAWSOfflineOperationName(t *testing.T) {
+	cases := []struct {
+		Method   string
+		URL      string
+		Body     string
+		Target   string
+		Path     string
+		Expected string
+	}{
+		{"POST", "/sqs/", "Action=CreateQueue&QueueName=test", "", "/", "CreateQueue"},
+		{"POST", "/dynamodb/", "{}", "DynamoDB_20120810.DescribeTable", "/", "DescribeTable"},
+		{"PUT", "/s3/bucket", "", "", "/bucket", "PUT /bucket"},
+		{"GET", "/s3/bucket?versioning", "", "", "/bucket", "GET /bucket?versioning"},
+		{"GET", "/s3/bucket?tagging&acl", "", "", "/bucket", "GET /bucket?acl&tagging"},
+		{"GET", "/s3/bucket?versions&prefix=x", "", "", "/bucket", "GET /bucket?versions"},
+	}
+
+	for _, tc := range cases {
+		r := httptest.NewRequest(tc.Method, tc.URL, strings.NewReader(tc.Body))
+		if tc.Body != "" && tc.Target == "" {
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		if tc.Target != "" {
+			r.Header.Set("X-Amz-Target", tc.Target)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+
+		if operation := awsOfflineOperationName(r, tc.Path); operation != tc.Expected {
+			t.Errorf("%s %s: expected operation %q, got %q", tc.Method, tc.URL, tc.Expected, operation)
+		}
+	}
+}
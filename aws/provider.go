@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +20,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +45,12 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -75,6 +83,15 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_retry_backoff": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["max_retry_backoff"],
+				ValidateFunc: validatePositiveDuration,
+			},
+
+			"retryable_error_codes": retryableErrorCodesSchema(),
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -261,6 +278,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
 			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
 			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                     resourceAwsApiGatewayVpcLink(),
 			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
 			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
 			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
@@ -291,7 +309,12 @@ func Provider() terraform.ResourceProvider {
 			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
 			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
 			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_resource_server":                  resourceAwsCognitoResourceServer(),
+			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
 			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
 			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
 			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
 			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
@@ -320,7 +343,15 @@ func Provider() terraform.ResourceProvider {
 			"aws_dx_lag":                                   resourceAwsDxLag(),
 			"aws_dx_connection":                            resourceAwsDxConnection(),
 			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
+			"aws_dx_gateway":                               resourceAwsDxGateway(),
+			"aws_dx_gateway_association":                   resourceAwsDxGatewayAssociation(),
+			"aws_dx_hosted_private_virtual_interface_accepter": resourceAwsDxHostedPrivateVirtualInterfaceAccepter(),
+			"aws_dx_hosted_public_virtual_interface_accepter": resourceAwsDxHostedPublicVirtualInterfaceAccepter(),
+			"aws_dx_private_virtual_interface":             resourceAwsDxPrivateVirtualInterface(),
+			"aws_dx_public_virtual_interface":              resourceAwsDxPublicVirtualInterface(),
+			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
 			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_backup":                    resourceAwsDynamoDbTableBackup(),
 			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
 			"aws_ebs_volume":                               resourceAwsEbsVolume(),
 			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
@@ -354,6 +385,17 @@ func Provider() terraform.ResourceProvider {
 			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
 			"aws_flow_log":                                 resourceAwsFlowLog(),
 			"aws_glacier_vault":                            resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                       resourceAwsGlueCatalogTable(),
+			"aws_glue_connection":                          resourceAwsGlueConnection(),
+			"aws_glue_crawler":                             resourceAwsGlueCrawler(),
+			"aws_glue_job":                                 resourceAwsGlueJob(),
+			"aws_glue_trigger":                             resourceAwsGlueTrigger(),
+			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
+			"aws_guardduty_invite_accepter":                resourceAwsGuardDutyInviteAccepter(),
+			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
 			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
 			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
 			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
@@ -392,6 +434,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
 			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
 			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                          resourceAwsLaunchTemplate(),
 			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
@@ -459,6 +502,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
 			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
 			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                         resourceAwsS3BucketMetric(),
+			"aws_s3_bucket_inventory":                      resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_analytics_configuration":        resourceAwsS3BucketAnalyticsConfiguration(),
 			"aws_security_group":                           resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
@@ -466,6 +512,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
 			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
 			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                           resourceAwsSsmActivation(),
 			"aws_ssm_association":                          resourceAwsSsmAssociation(),
@@ -499,22 +546,36 @@ func Provider() terraform.ResourceProvider {
 			"aws_default_vpc":                              resourceAwsDefaultVpc(),
 			"aws_vpc":                                      resourceAwsVpc(),
 			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":     resourceAwsVpcEndpointConnectionNotification(),
 			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
+			"aws_vpc_ipv4_cidr_block_association":          resourceAwsVpcIpv4CidrBlockAssociation(),
 			"aws_vpn_connection":                           resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
 			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
 			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
+			"aws_waf_geo_match_set":                        resourceAwsWafGeoMatchSet(),
 			"aws_waf_ipset":                                resourceAwsWafIPSet(),
 			"aws_waf_rule":                                 resourceAwsWafRule(),
+			"aws_waf_rule_group":                           resourceAwsWafRuleGroup(),
 			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
+			"aws_waf_regex_match_set":                      resourceAwsWafRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                    resourceAwsWafRegexPatternSet(),
 			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
 			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
 			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
 			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
 			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
 			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rate_based_rule":              resourceAwsWafRegionalRateBasedRule(),
+			"aws_wafregional_rule":                         resourceAwsWafRegionalRule(),
+			"aws_wafregional_size_constraint_set":          resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":      resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_web_acl":                      resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":          resourceAwsWafRegionalWebAclAssociation(),
+			"aws_wafregional_xss_match_set":                resourceAwsWafRegionalXssMatchSet(),
 			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
 			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
 			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
@@ -536,6 +597,14 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range provider.ResourcesMap {
+		if defaultTagsResource(r) {
+			addDefaultTags(r)
+		}
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -564,17 +633,13 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"apigateway_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"max_retry_backoff": "The maximum time to wait between two attempts of an AWS API\n" +
+			"request, e.g. `30s`. Defaults to the exponential backoff of the AWS SDK.",
 
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"retryable_error_codes": "Additional error codes on which AWS API requests to a service\n" +
+			"are retried, up to `max_retries` times.",
 
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -582,24 +647,6 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"lambda_endpoint": "Use this to override the default endpoint URL constructed from the `region`\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
 			"default value is `false`",
 
@@ -634,6 +681,31 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session. If omitted," +
+			" the session lasts 15 minutes.",
+
+		"assume_role_with_web_identity": "Configuration block to assume a role with a web identity token" +
+			" before making API calls or assuming any role in `assume_role`.",
+
+		"assume_role_with_web_identity_role_arn": "The ARN of an IAM role to assume with the web identity token.",
+
+		"assume_role_with_web_identity_session_name": "The session name to use when assuming the role." +
+			" If omitted, a session name is generated.",
+
+		"assume_role_with_web_identity_web_identity_token_file": "The path to a file containing an OAuth 2.0" +
+			" access token or OpenID Connect ID token.",
+
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on the resource\n" +
+			"take precedence over these defaults.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
 	}
 }
 
@@ -661,44 +733,60 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	assumeRoleList := d.Get("assume_role").([]interface{})
+	for _, assumeRoleI := range assumeRoleList {
+		if assumeRoleI == nil {
+			continue
+		}
+		assumeRole := assumeRoleI.(map[string]interface{})
+		if assumeRole["role_arn"].(string) == "" {
+			continue
+		}
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+		ar := &AssumeRole{
+			RoleARN:         assumeRole["role_arn"].(string),
+			SessionName:     assumeRole["session_name"].(string),
+			ExternalID:      assumeRole["external_id"].(string),
+			Policy:          assumeRole["policy"].(string),
+			DurationSeconds: assumeRole["duration_seconds"].(int),
 		}
+		config.AssumeRoles = append(config.AssumeRoles, ar)
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, DurationSeconds: %d)",
+			ar.RoleARN, ar.SessionName, ar.ExternalID, ar.Policy, ar.DurationSeconds)
+	}
+	if len(config.AssumeRoles) == 0 {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	webIdentityList := d.Get("assume_role_with_web_identity").([]interface{})
+	if len(webIdentityList) == 1 && webIdentityList[0] != nil {
+		webIdentity := webIdentityList[0].(map[string]interface{})
+		tokenFile, err := homedir.Expand(webIdentity["web_identity_token_file"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+			RoleARN:              webIdentity["role_arn"].(string),
+			SessionName:          webIdentity["session_name"].(string),
+			WebIdentityTokenFile: tokenFile,
+			Policy:               webIdentity["policy"].(string),
+			DurationSeconds:      webIdentity["duration_seconds"].(int),
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, TokenFile: %q)",
+			config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName,
+			config.AssumeRoleWithWebIdentity.WebIdentityTokenFile)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
+		config.Endpoints = make(map[string]string)
+		for _, sc := range awsServiceClients {
+			config.Endpoints[sc.endpoint] = endpoints[sc.endpoint].(string)
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -709,17 +797,53 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ForbiddenAccountIds = v.(*schema.Set).List()
 	}
 
+	defaultTagsList := d.Get("default_tags").([]interface{})
+	if len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		defaultTags := defaultTagsList[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	ignoreTagsList := d.Get("ignore_tags").([]interface{})
+	if len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTagsConfig := ignoreTagsList[0].(map[string]interface{})
+		if v, ok := ignoreTagsConfig["keys"].(*schema.Set); ok {
+			for _, k := range v.List() {
+				config.IgnoreTagsKeys = append(config.IgnoreTagsKeys, k.(string))
+			}
+		}
+		if v, ok := ignoreTagsConfig["key_prefixes"].(*schema.Set); ok {
+			for _, k := range v.List() {
+				config.IgnoreTagsKeyPrefixes = append(config.IgnoreTagsKeyPrefixes, k.(string))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("max_retry_backoff"); ok {
+		// Validated by the schema
+		config.MaxRetryBackoff, _ = time.ParseDuration(v.(string))
+	}
+
+	config.RetryableErrorCodes = make(map[string][]string)
+	for _, v := range d.Get("retryable_error_codes").(*schema.Set).List() {
+		retryableErrorCodes := v.(map[string]interface{})
+		service := retryableErrorCodes["service"].(string)
+		for _, code := range retryableErrorCodes["error_codes"].(*schema.Set).List() {
+			config.RetryableErrorCodes[service] = append(config.RetryableErrorCodes[service], code.(string))
+		}
+	}
+
 	return config.Client()
 }
 
 // This is a global MutexKV for use within this plugin.
 var awsMutexKV = mutexkv.NewMutexKV()
 
+// assumeRoleSchema returns the schema for the ordered list of roles to
+// assume. Each role is assumed using the credentials of the previous one.
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -745,123 +869,114 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
 			},
 		},
 	}
 }
 
-func endpointsSchema() *schema.Schema {
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["assume_role_with_web_identity"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
+				"role_arn": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_role_arn"],
 				},
 
-				"ec2": {
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Description: descriptions["assume_role_with_web_identity_session_name"],
 				},
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kms": {
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
-				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_web_identity_token_file"],
 				},
-				"rds": {
+
+				"policy": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
+					Description: descriptions["assume_role_policy"],
 				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+			},
+		},
+	}
+}
+
+// retryableErrorCodesSchema returns the schema for the extra error codes to
+// retry, per service named in the endpoints block.
+func retryableErrorCodesSchema() *schema.Schema {
+	services := make([]string, 0, len(awsServiceClients))
+	for _, sc := range awsServiceClients {
+		services = append(services, sc.endpoint)
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: descriptions["retryable_error_codes"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(services, false),
 				},
-				"sqs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+				"error_codes": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
 				},
 			},
 		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, sc := range awsServiceClients {
+		description, ok := descriptions[sc.endpoint+"_endpoint"]
+		if !ok {
+			description = descriptions["endpoint"]
+		}
+
+		endpointsAttributes[sc.endpoint] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -869,23 +984,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, sc := range awsServiceClients {
+		buf.WriteString(fmt.Sprintf("%s-", m[sc.endpoint].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -233,7 +233,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	d.Set("tags_all", tagsToMap(image.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -243,10 +243,10 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := setTags(client, d); err != nil {
+	if err := setTags(client, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
-		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.Get("description").(string) != "" {
@@ -17,6 +17,19 @@ func resourceAwsApiGatewayAuthorizer() *schema.Resource {
 		Read:   resourceAwsApiGatewayAuthorizerRead,
 		Update: resourceAwsApiGatewayAuthorizerUpdate,
 		Delete: resourceAwsApiGatewayAuthorizerDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/AUTHORIZER-ID", d.Id())
+				}
+				restApiID := idParts[0]
+				authorizerID := idParts[1]
+				d.Set("rest_api_id", restApiID)
+				d.SetId(authorizerID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"authorizer_uri": &schema.Schema{
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,24 @@ func resourceAwsApiGatewayBasePathMapping() *schema.Resource {
 		Create: resourceAwsApiGatewayBasePathMappingCreate,
 		Read:   resourceAwsApiGatewayBasePathMappingRead,
 		Delete: resourceAwsApiGatewayBasePathMappingDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// The base path may legitimately be empty, e.g. "example.com/"
+				idParts := strings.SplitN(d.Id(), "/", 2)
+				if len(idParts) != 2 || idParts[0] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected DOMAIN-NAME/BASE-PATH", d.Id())
+				}
+				domainName := idParts[0]
+				basePath := idParts[1]
+				if basePath == emptyBasePathMappingValue {
+					basePath = ""
+				}
+				d.Set("domain_name", domainName)
+				d.Set("base_path", basePath)
+				d.SetId(fmt.Sprintf("%s/%s", domainName, basePath))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"api_id": {
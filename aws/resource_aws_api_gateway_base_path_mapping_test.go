@@ -28,6 +28,11 @@ func TestAccAWSAPIGatewayBasePath_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayBasePathExists("aws_api_gateway_base_path_mapping.test", name, &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_base_path_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -50,6 +55,11 @@ func TestAccAWSAPIGatewayEmptyBasePath_basic(t *testing.T) {
 					testAccCheckAWSAPIGatewayEmptyBasePathExists("aws_api_gateway_base_path_mapping.test", name, &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_base_path_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -33,6 +33,13 @@ func TestAccAWSAPIGatewayDeployment_basic(t *testing.T) {
 						"aws_api_gateway_deployment.test", "created_date"),
 				),
 			},
+			{
+				ResourceName:            "aws_api_gateway_deployment.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayDeploymentImportStateIdFunc("aws_api_gateway_deployment.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"stage_name", "stage_description", "variables", "invoke_url", "execution_arn"},
+			},
 		},
 	})
 }
@@ -69,6 +76,17 @@ func testAccCheckAWSAPIGatewayDeploymentExists(n string, res *apigateway.Deploym
 	}
 }
 
+func testAccAWSAPIGatewayDeploymentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayDeploymentDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,20 @@ func resourceAwsApiGatewayGatewayResponse() *schema.Resource {
 		Read:   resourceAwsApiGatewayGatewayResponseRead,
 		Update: resourceAwsApiGatewayGatewayResponsePut,
 		Delete: resourceAwsApiGatewayGatewayResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/RESPONSE-TYPE", d.Id())
+				}
+				restApiID := idParts[0]
+				responseType := idParts[1]
+				d.Set("rest_api_id", restApiID)
+				d.Set("response_type", responseType)
+				d.SetId(fmt.Sprintf("aggr-%s-%s", restApiID, responseType))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
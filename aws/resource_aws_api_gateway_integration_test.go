@@ -2,11 +2,13 @@ package aws
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -89,6 +91,13 @@ func TestAccAWSAPIGatewayIntegration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "request_templates.application/xml", "#set($inputRoot = $input.path('$'))\n{ }"),
 				),
 			},
+			{
+				ResourceName:            "aws_api_gateway_integration.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayIntegrationImportStateIdFunc("aws_api_gateway_integration.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"request_parameters_in_json"},
+			},
 		},
 	})
 }
@@ -127,6 +136,36 @@ func TestAccAWSAPIGatewayIntegration_cache_key_parameters(t *testing.T) {
 	})
 }
 
+func TestAccAWSAPIGatewayIntegration_integrationType(t *testing.T) {
+	var conf apigateway.Integration
+
+	rName := fmt.Sprintf("tf-acctest-apigw-int-%s", acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeInternet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayIntegrationExists("aws_api_gateway_integration.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_type", "INTERNET"),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_id", ""),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeVpcLink(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayIntegrationExists("aws_api_gateway_integration.test", &conf),
+					resource.TestCheckResourceAttr("aws_api_gateway_integration.test", "connection_type", "VPC_LINK"),
+					resource.TestMatchResourceAttr("aws_api_gateway_integration.test", "connection_id", regexp.MustCompile("^[0-9a-z]+$")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAPIGatewayIntegrationExists(n string, res *apigateway.Integration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -156,6 +195,17 @@ func testAccCheckAWSAPIGatewayIntegrationExists(n string, res *apigateway.Integr
 	}
 }
 
+func testAccAWSAPIGatewayIntegrationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["http_method"]), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayIntegrationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
@@ -366,3 +416,87 @@ resource "aws_api_gateway_integration" "test" {
   content_handling = "CONVERT_TO_TEXT"
 }
 `
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.10.0.0/16"
+
+  tags {
+    Name = "%s"
+  }
+}
+
+data "aws_availability_zones" "test" {}
+
+resource "aws_subnet" "test" {
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.10.0.0/24"
+  availability_zone = "${data.aws_availability_zones.test.names[0]}"
+}
+
+resource "aws_lb" "test" {
+  name               = "%s"
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.test.id}"]
+}
+
+resource "aws_api_gateway_vpc_link" "test" {
+  name        = "%s"
+  target_arns = ["${aws_lb.test.arn}"]
+}
+
+resource "aws_api_gateway_rest_api" "test" {
+  name = "%s"
+}
+
+resource "aws_api_gateway_resource" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  parent_id   = "${aws_api_gateway_rest_api.test.root_resource_id}"
+  path_part   = "test"
+}
+
+resource "aws_api_gateway_method" "test" {
+  rest_api_id   = "${aws_api_gateway_rest_api.test.id}"
+  resource_id   = "${aws_api_gateway_resource.test.id}"
+  http_method   = "GET"
+  authorization = "NONE"
+}
+`, rName, rName, rName, rName)
+}
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeVpcLink(rName string) string {
+	return testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName) + `
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  http_method = "${aws_api_gateway_method.test.http_method}"
+
+  type                    = "HTTP"
+  uri                     = "https://www.google.de"
+  integration_http_method = "GET"
+  passthrough_behavior    = "WHEN_NO_MATCH"
+  content_handling        = "CONVERT_TO_TEXT"
+
+  connection_type = "VPC_LINK"
+  connection_id   = "${aws_api_gateway_vpc_link.test.id}"
+}
+`
+}
+
+func testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeInternet(rName string) string {
+	return testAccAWSAPIGatewayIntegrationConfig_IntegrationTypeBase(rName) + `
+resource "aws_api_gateway_integration" "test" {
+  rest_api_id = "${aws_api_gateway_rest_api.test.id}"
+  resource_id = "${aws_api_gateway_resource.test.id}"
+  http_method = "${aws_api_gateway_method.test.http_method}"
+
+  type                    = "HTTP"
+  uri                     = "https://www.google.de"
+  integration_http_method = "GET"
+  passthrough_behavior    = "WHEN_NO_MATCH"
+  content_handling        = "CONVERT_TO_TEXT"
+}
+`
+}
@@ -42,6 +42,13 @@ func TestAccAWSAPIGatewayMethodResponse_basic(t *testing.T) {
 						"aws_api_gateway_method_response.error", "response_models.application/json", "Empty"),
 				),
 			},
+			{
+				ResourceName:            "aws_api_gateway_method_response.error",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSAPIGatewayMethodResponseImportStateIdFunc("aws_api_gateway_method_response.error"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"response_parameters_in_json"},
+			},
 		},
 	})
 }
@@ -118,6 +125,17 @@ func testAccCheckAWSAPIGatewayMethodResponseExists(n string, res *apigateway.Met
 	}
 }
 
+func testAccAWSAPIGatewayMethodResponseImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["http_method"], rs.Primary.Attributes["status_code"]), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayMethodResponseDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
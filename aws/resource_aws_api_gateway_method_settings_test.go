@@ -44,6 +44,12 @@ func TestAccAWSAPIGatewayMethodSettings_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_method_settings.test", "settings.0.logging_level", "OFF"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_method_settings.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayMethodSettingsImportStateIdFunc("aws_api_gateway_method_settings.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -109,6 +115,17 @@ func testAccCheckAWSAPIGatewayMethodSettingsExists(n string, res *apigateway.Sta
 	}
 }
 
+func testAccAWSAPIGatewayMethodSettingsImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["stage_name"], rs.Primary.Attributes["method_path"]), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayMethodSettingsDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
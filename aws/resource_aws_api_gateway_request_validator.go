@@ -16,6 +16,19 @@ func resourceAwsApiGatewayRequestValidator() *schema.Resource {
 		Read:   resourceAwsApiGatewayRequestValidatorRead,
 		Update: resourceAwsApiGatewayRequestValidatorUpdate,
 		Delete: resourceAwsApiGatewayRequestValidatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/REQUEST-VALIDATOR-ID", d.Id())
+				}
+				restApiID := idParts[0]
+				requestValidatorID := idParts[1]
+				d.Set("rest_api_id", restApiID)
+				d.SetId(requestValidatorID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
@@ -30,6 +30,12 @@ func TestAccAWSAPIGatewayResource_basic(t *testing.T) {
 						"aws_api_gateway_resource.test", "path", "/test"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_resource.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayResourceImportStateIdFunc("aws_api_gateway_resource.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -111,6 +117,17 @@ func testAccCheckAWSAPIGatewayResourceExists(n string, res *apigateway.Resource)
 	}
 }
 
+func testAccAWSAPIGatewayResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayResourceDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
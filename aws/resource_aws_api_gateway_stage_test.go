@@ -45,6 +45,12 @@ func TestAccAWSAPIGatewayStage_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_api_gateway_stage.test", "cache_cluster_size", "0.5"),
 				),
 			},
+			{
+				ResourceName:      "aws_api_gateway_stage.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayStageImportStateIdFunc("aws_api_gateway_stage.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -77,6 +83,17 @@ func testAccCheckAWSAPIGatewayStageExists(n string, res *apigateway.Stage) resou
 	}
 }
 
+func testAccAWSAPIGatewayStageImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["rest_api_id"], rs.Primary.Attributes["stage_name"]), nil
+	}
+}
+
 func testAccCheckAWSAPIGatewayStageDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).apigateway
 
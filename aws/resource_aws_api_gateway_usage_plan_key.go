@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,20 @@ func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
 		Create: resourceAwsApiGatewayUsagePlanKeyCreate,
 		Read:   resourceAwsApiGatewayUsagePlanKeyRead,
 		Delete: resourceAwsApiGatewayUsagePlanKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected USAGE-PLAN-ID/USAGE-PLAN-KEY-ID", d.Id())
+				}
+				usagePlanID := idParts[0]
+				keyID := idParts[1]
+				d.Set("usage_plan_id", usagePlanID)
+				d.Set("key_id", keyID)
+				d.SetId(keyID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"key_id": {
@@ -88,6 +103,7 @@ func resourceAwsApiGatewayUsagePlanKeyRead(d *schema.ResourceData, meta interfac
 	}
 
 	d.Set("name", up.Name)
+	d.Set("key_type", up.Type)
 	d.Set("value", up.Value)
 
 	return nil
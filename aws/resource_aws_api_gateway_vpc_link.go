@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsApiGatewayVpcLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayVpcLinkCreate,
+		Read:   resourceAwsApiGatewayVpcLinkRead,
+		Update: resourceAwsApiGatewayVpcLinkUpdate,
+		Delete: resourceAwsApiGatewayVpcLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_arns": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayVpcLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	input := &apigateway.CreateVpcLinkInput{
+		Name:       aws.String(d.Get("name").(string)),
+		TargetArns: expandStringList(d.Get("target_arns").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway VPC Link: %s", input)
+	resp, err := conn.CreateVpcLink(input)
+	if err != nil {
+		return fmt.Errorf("Error creating API Gateway VPC Link: %s", err)
+	}
+
+	d.SetId(*resp.Id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigateway.VpcLinkStatusPending},
+		Target:     []string{apigateway.VpcLinkStatusAvailable},
+		Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, *resp.Id),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error waiting for API Gateway VPC Link (%s) to become available: %s", *resp.Id, err)
+	}
+
+	return resourceAwsApiGatewayVpcLinkRead(d, meta)
+}
+
+func resourceAwsApiGatewayVpcLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	input := &apigateway.GetVpcLinkInput{
+		VpcLinkId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetVpcLink(input)
+	if err != nil {
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] API Gateway VPC Link %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading API Gateway VPC Link (%s): %s", d.Id(), err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("description", resp.Description)
+	if err := d.Set("target_arns", flattenStringList(resp.TargetArns)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceAwsApiGatewayVpcLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	operations := make([]*apigateway.PatchOperation, 0)
+
+	if d.HasChange("name") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/name"),
+			Value: aws.String(d.Get("name").(string)),
+		})
+	}
+
+	if d.HasChange("description") {
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String("replace"),
+			Path:  aws.String("/description"),
+			Value: aws.String(d.Get("description").(string)),
+		})
+	}
+
+	input := &apigateway.UpdateVpcLinkInput{
+		VpcLinkId:       aws.String(d.Id()),
+		PatchOperations: operations,
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway VPC Link: %s", input)
+	_, err := conn.UpdateVpcLink(input)
+	if err != nil {
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			log.Printf("[WARN] API Gateway VPC Link %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error updating API Gateway VPC Link (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigateway.VpcLinkStatusPending},
+		Target:     []string{apigateway.VpcLinkStatusAvailable},
+		Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for API Gateway VPC Link (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayVpcLinkRead(d, meta)
+}
+
+func resourceAwsApiGatewayVpcLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+
+	input := &apigateway.DeleteVpcLinkInput{
+		VpcLinkId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting API Gateway VPC Link: %s", d.Id())
+	_, err := conn.DeleteVpcLink(input)
+	if err != nil {
+		if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting API Gateway VPC Link (%s): %s", d.Id(), err)
+	}
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{
+			apigateway.VpcLinkStatusPending,
+			apigateway.VpcLinkStatusAvailable,
+			apigateway.VpcLinkStatusDeleting,
+		},
+		Target:     []string{""},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.GetVpcLink(&apigateway.GetVpcLinkInput{
+				VpcLinkId: aws.String(d.Id()),
+			})
+			if err != nil {
+				if isAWSErr(err, apigateway.ErrCodeNotFoundException, "") {
+					return 1, "", nil
+				}
+				return nil, "failed", err
+			}
+			return resp, *resp.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for API Gateway VPC Link (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func apigatewayVpcLinkRefreshStatusFunc(conn *apigateway.APIGateway, vl string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &apigateway.GetVpcLinkInput{
+			VpcLinkId: aws.String(vl),
+		}
+		resp, err := conn.GetVpcLink(input)
+		if err != nil {
+			return nil, "failed", err
+		}
+		if aws.StringValue(resp.Status) == apigateway.VpcLinkStatusFailed {
+			return resp, *resp.Status, fmt.Errorf("API Gateway VPC Link (%s) failed: %s", vl, aws.StringValue(resp.StatusMessage))
+		}
+		return resp, *resp.Status, nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -63,8 +64,38 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
+			},
+
+			"launch_template": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"launch_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "$Default",
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
 			},
 
 			"desired_capacity": {
@@ -319,13 +350,27 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
 
+	launchConfigurationValue, launchConfigurationOk := d.GetOk("launch_configuration")
+	launchTemplateValue, launchTemplateOk := d.GetOk("launch_template")
+
+	if !launchConfigurationOk && !launchTemplateOk {
+		return fmt.Errorf("One of `launch_configuration` or `launch_template` must be set for an autoscaling group")
+	}
+
+	if launchConfigurationOk {
+		createOpts.LaunchConfigurationName = aws.String(launchConfigurationValue.(string))
+	}
+
+	if launchTemplateOk {
+		createOpts.LaunchTemplate = expandLaunchTemplateSpecification(launchTemplateValue.([]interface{}))
+	}
+
 	initialLifecycleHooks := d.Get("initial_lifecycle_hook").(*schema.Set).List()
 	twoPhases := len(initialLifecycleHooks) > 0
 
@@ -360,14 +405,14 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("tag"); ok {
 		var err error
 		createOpts.Tags, err = autoscalingTagsFromMap(
-			setToMapByKey(v.(*schema.Set), "key"), resourceID)
+			setToMapByKey(v.(*schema.Set), "key"), resourceID, meta.(*AWSClient).ignoreTagsConfig)
 		if err != nil {
 			return err
 		}
 	}
 
 	if v, ok := d.GetOk("tags"); ok {
-		tags, err := autoscalingTagsFromList(v.([]interface{}), resourceID)
+		tags, err := autoscalingTagsFromList(v.([]interface{}), resourceID, meta.(*AWSClient).ignoreTagsConfig)
 		if err != nil {
 			return err
 		}
@@ -471,6 +516,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenLaunchTemplateSpecification(g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -574,7 +622,22 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			opts.LaunchTemplate = expandLaunchTemplateSpecification(v.([]interface{}))
+
+			// Both id and name are computed, so the state still carries the
+			// id of the previous template when only the name was changed.
+			if d.HasChange("launch_template.0.name") && !d.HasChange("launch_template.0.id") {
+				opts.LaunchTemplate.LaunchTemplateId = nil
+				opts.LaunchTemplate.LaunchTemplateName = aws.String(d.Get("launch_template.0.name").(string))
+			}
+		}
 	}
 
 	if d.HasChange("min_size") {
@@ -620,7 +683,7 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if err := setAutoscalingTags(conn, d); err != nil {
+	if err := setAutoscalingTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -1043,3 +1106,46 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+func expandLaunchTemplateSpecification(specs []interface{}) *autoscaling.LaunchTemplateSpecification {
+	if len(specs) < 1 || specs[0] == nil {
+		return nil
+	}
+
+	spec := specs[0].(map[string]interface{})
+	result := &autoscaling.LaunchTemplateSpecification{}
+
+	// The id and name arguments are both computed, so only one of them
+	// may be sent to the API; prefer the id when both are known.
+	if v, ok := spec["id"]; ok && v.(string) != "" {
+		result.LaunchTemplateId = aws.String(v.(string))
+	} else if v, ok := spec["name"]; ok && v.(string) != "" {
+		result.LaunchTemplateName = aws.String(v.(string))
+	}
+
+	if v, ok := spec["version"]; ok && v.(string) != "" {
+		result.Version = aws.String(v.(string))
+	}
+
+	return result
+}
+
+func flattenLaunchTemplateSpecification(lt *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if lt == nil {
+		return []interface{}{}
+	}
+
+	attrs := map[string]interface{}{
+		"id":   aws.StringValue(lt.LaunchTemplateId),
+		"name": aws.StringValue(lt.LaunchTemplateName),
+	}
+
+	// Version is returned only when it was explicitly specified.
+	if lt.Version != nil {
+		attrs["version"] = aws.StringValue(lt.Version)
+	} else {
+		attrs["version"] = "$Default"
+	}
+
+	return []interface{}{attrs}
+}
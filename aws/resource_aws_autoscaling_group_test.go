@@ -878,6 +878,94 @@ func TestAccAWSAutoScalingGroup_emptyAvailabilityZones(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"aws_autoscaling_group.bar", "launch_template.0.id",
+						"aws_launch_template.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"aws_autoscaling_group.bar", "launch_template.0.name",
+						"aws_launch_template.foobar", "name"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "$Latest"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_autoscaling_group.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"force_delete", "metrics_granularity", "wait_for_capacity_timeout"},
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_launchTemplateVersionUpdate(t *testing.T) {
+	var group autoscaling.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplateVersion("t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplateVersion("t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_launchConfigurationToLaunchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_autoGeneratedName,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttrSet("aws_autoscaling_group.bar", "launch_configuration"),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_withLaunchTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 const testAccAWSAutoScalingGroupConfig_autoGeneratedName = `
 resource "aws_launch_configuration" "foobar" {
   image_id = "ami-21f78e11"
@@ -1873,3 +1961,73 @@ resource "aws_launch_configuration" "test" {
   instance_type = "t1.micro"
 }
 `
+
+const testAccAWSAutoScalingGroupConfig_withLaunchTemplate = `
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "foobar" {
+  name_prefix   = "foobar"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    id      = "${aws_launch_template.foobar.id}"
+    version = "$Latest"
+  }
+}
+`
+
+func testAccAWSAutoScalingGroupConfig_withLaunchTemplateVersion(instanceType string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "foobar" {
+  name_prefix   = "foobar"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "%s"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  desired_capacity   = 0
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    name    = "${aws_launch_template.foobar.name}"
+    version = "${aws_launch_template.foobar.latest_version}"
+  }
+}
+`, instanceType)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsAutoscalingPolicy() *schema.Resource {
@@ -31,7 +32,7 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 			},
 			"adjustment_type": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"autoscaling_group_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -93,6 +94,97 @@ func resourceAwsAutoscalingPolicy() *schema.Resource {
 				},
 				Set: resourceAwsAutoscalingScalingAdjustmentHash,
 			},
+			"target_tracking_configuration": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predefined_metric_specification": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"target_tracking_configuration.0.customized_metric_specification"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"predefined_metric_type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											autoscaling.MetricTypeAsgaverageCpuutilization,
+											autoscaling.MetricTypeAsgaverageNetworkIn,
+											autoscaling.MetricTypeAsgaverageNetworkOut,
+											autoscaling.MetricTypeAlbrequestCountPerTarget,
+										}, false),
+									},
+									"resource_label": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"customized_metric_specification": &schema.Schema{
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"target_tracking_configuration.0.predefined_metric_specification"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_dimension": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": &schema.Schema{
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"metric_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"namespace": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"statistic": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											autoscaling.MetricStatisticAverage,
+											autoscaling.MetricStatisticMinimum,
+											autoscaling.MetricStatisticMaximum,
+											autoscaling.MetricStatisticSampleCount,
+											autoscaling.MetricStatisticSum,
+										}, false),
+									},
+									"unit": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"target_value": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Required: true,
+						},
+						"disable_scale_in": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -147,6 +239,9 @@ func resourceAwsAutoscalingPolicyRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", p.PolicyName)
 	d.Set("scaling_adjustment", p.ScalingAdjustment)
 	d.Set("step_adjustment", flattenStepAdjustments(p.StepAdjustments))
+	if err := d.Set("target_tracking_configuration", flattenTargetTrackingConfiguration(p.TargetTrackingConfiguration)); err != nil {
+		return fmt.Errorf("Error setting target_tracking_configuration: %s", err)
+	}
 
 	return nil
 }
@@ -233,6 +328,10 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 		params.StepAdjustments = steps
 	}
 
+	if v, ok := d.GetOk("target_tracking_configuration"); ok {
+		params.TargetTrackingConfiguration = expandTargetTrackingConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("min_adjustment_magnitude"); ok {
 		// params.MinAdjustmentMagnitude = aws.Int64(int64(d.Get("min_adjustment_magnitude").(int)))
 		params.MinAdjustmentMagnitude = aws.Int64(int64(v.(int)))
@@ -252,6 +351,9 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 	if *params.PolicyType == "SimpleScaling" && params.EstimatedInstanceWarmup != nil {
 		return params, fmt.Errorf("SimpleScaling policy types cannot use estimated_instance_warmup!")
 	}
+	if *params.PolicyType == "SimpleScaling" && params.TargetTrackingConfiguration != nil {
+		return params, fmt.Errorf("SimpleScaling policy types cannot use target_tracking_configuration!")
+	}
 
 	// Second, StepScaling policy types...
 	if *params.PolicyType == "StepScaling" && params.ScalingAdjustment != nil {
@@ -260,6 +362,32 @@ func getAwsAutoscalingPutScalingPolicyInput(d *schema.ResourceData) (autoscaling
 	if *params.PolicyType == "StepScaling" && params.Cooldown != nil {
 		return params, fmt.Errorf("StepScaling policy types cannot use cooldown!")
 	}
+	if *params.PolicyType == "StepScaling" && params.TargetTrackingConfiguration != nil {
+		return params, fmt.Errorf("StepScaling policy types cannot use target_tracking_configuration!")
+	}
+
+	// Third, TargetTrackingScaling policy types...
+	if *params.PolicyType == "TargetTrackingScaling" && params.AdjustmentType != nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use adjustment_type!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && params.Cooldown != nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use cooldown!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && params.MetricAggregationType != nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use metric_aggregation_type!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && (params.MinAdjustmentMagnitude != nil || params.MinAdjustmentStep != nil) {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use min_adjustment_magnitude!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && params.ScalingAdjustment != nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use scaling_adjustment!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && params.StepAdjustments != nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types cannot use step_adjustments!")
+	}
+	if *params.PolicyType == "TargetTrackingScaling" && params.TargetTrackingConfiguration == nil {
+		return params, fmt.Errorf("TargetTrackingScaling policy types must have target_tracking_configuration!")
+	}
 
 	return params, nil
 }
@@ -310,3 +438,98 @@ func resourceAwsAutoscalingScalingAdjustmentHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func expandTargetTrackingConfiguration(configs []interface{}) *autoscaling.TargetTrackingConfiguration {
+	if len(configs) < 1 {
+		return nil
+	}
+
+	config := configs[0].(map[string]interface{})
+
+	result := &autoscaling.TargetTrackingConfiguration{
+		DisableScaleIn: aws.Bool(config["disable_scale_in"].(bool)),
+		TargetValue:    aws.Float64(config["target_value"].(float64)),
+	}
+
+	if v, ok := config["predefined_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		spec := v[0].(map[string]interface{})
+		predSpec := &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(spec["predefined_metric_type"].(string)),
+		}
+		if val, ok := spec["resource_label"].(string); ok && val != "" {
+			predSpec.ResourceLabel = aws.String(val)
+		}
+		result.PredefinedMetricSpecification = predSpec
+	}
+
+	if v, ok := config["customized_metric_specification"].([]interface{}); ok && len(v) > 0 {
+		spec := v[0].(map[string]interface{})
+		customSpec := &autoscaling.CustomizedMetricSpecification{
+			MetricName: aws.String(spec["metric_name"].(string)),
+			Namespace:  aws.String(spec["namespace"].(string)),
+			Statistic:  aws.String(spec["statistic"].(string)),
+		}
+		if val, ok := spec["unit"].(string); ok && val != "" {
+			customSpec.Unit = aws.String(val)
+		}
+		if val, ok := spec["metric_dimension"].([]interface{}); ok && len(val) > 0 {
+			dims := make([]*autoscaling.MetricDimension, len(val))
+			for i := range dims {
+				dim := val[i].(map[string]interface{})
+				dims[i] = &autoscaling.MetricDimension{
+					Name:  aws.String(dim["name"].(string)),
+					Value: aws.String(dim["value"].(string)),
+				}
+			}
+			customSpec.Dimensions = dims
+		}
+		result.CustomizedMetricSpecification = customSpec
+	}
+
+	return result
+}
+
+func flattenTargetTrackingConfiguration(config *autoscaling.TargetTrackingConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"disable_scale_in": aws.BoolValue(config.DisableScaleIn),
+		"target_value":     aws.Float64Value(config.TargetValue),
+	}
+
+	if config.PredefinedMetricSpecification != nil {
+		spec := map[string]interface{}{
+			"predefined_metric_type": aws.StringValue(config.PredefinedMetricSpecification.PredefinedMetricType),
+		}
+		if config.PredefinedMetricSpecification.ResourceLabel != nil {
+			spec["resource_label"] = aws.StringValue(config.PredefinedMetricSpecification.ResourceLabel)
+		}
+		result["predefined_metric_specification"] = []map[string]interface{}{spec}
+	}
+
+	if config.CustomizedMetricSpecification != nil {
+		spec := map[string]interface{}{
+			"metric_name": aws.StringValue(config.CustomizedMetricSpecification.MetricName),
+			"namespace":   aws.StringValue(config.CustomizedMetricSpecification.Namespace),
+			"statistic":   aws.StringValue(config.CustomizedMetricSpecification.Statistic),
+		}
+		if config.CustomizedMetricSpecification.Unit != nil {
+			spec["unit"] = aws.StringValue(config.CustomizedMetricSpecification.Unit)
+		}
+		if len(config.CustomizedMetricSpecification.Dimensions) > 0 {
+			dims := make([]interface{}, len(config.CustomizedMetricSpecification.Dimensions))
+			for i, dim := range config.CustomizedMetricSpecification.Dimensions {
+				dims[i] = map[string]interface{}{
+					"name":  aws.StringValue(dim.Name),
+					"value": aws.StringValue(dim.Value),
+				}
+			}
+			spec["metric_dimension"] = dims
+		}
+		result["customized_metric_specification"] = []map[string]interface{}{spec}
+	}
+
+	return []interface{}{result}
+}
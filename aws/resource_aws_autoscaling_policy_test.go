@@ -375,3 +375,97 @@ resource "aws_autoscaling_policy" "foobar_simple" {
 }
 `, name, name, name)
 }
+
+func TestAccAWSAutoscalingPolicy_TargetTrack(t *testing.T) {
+	var policy autoscaling.ScalingPolicy
+
+	name := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoscalingPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsAutoscalingPolicyConfig_TargetTracking(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.test", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "policy_type", "TargetTrackingScaling"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.0.predefined_metric_specification.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.0.predefined_metric_specification.0.predefined_metric_type", "ASGAverageCPUUtilization"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test", "target_tracking_configuration.0.target_value", "40"),
+					testAccCheckScalingPolicyExists("aws_autoscaling_policy.test_custom", &policy),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test_custom", "target_tracking_configuration.0.customized_metric_specification.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test_custom", "target_tracking_configuration.0.customized_metric_specification.0.metric_dimension.#", "1"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test_custom", "target_tracking_configuration.0.customized_metric_specification.0.statistic", "Average"),
+					resource.TestCheckResourceAttr("aws_autoscaling_policy.test_custom", "target_tracking_configuration.0.disable_scale_in", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsAutoscalingPolicyConfig_TargetTracking(name string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_configuration" "foobar" {
+  name          = "tf-test-%s"
+  image_id      = "ami-21f78e11"
+  instance_type = "t1.micro"
+}
+
+resource "aws_autoscaling_group" "foobar" {
+  availability_zones        = ["us-west-2a"]
+  name                      = "terraform-test-%s"
+  max_size                  = 5
+  min_size                  = 0
+  health_check_grace_period = 300
+  health_check_type         = "ELB"
+  force_delete              = true
+  termination_policies      = ["OldestInstance"]
+  launch_configuration      = "${aws_launch_configuration.foobar.name}"
+
+  tag {
+    key                 = "Foo"
+    value               = "foo-bar"
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_autoscaling_policy" "test" {
+  name                   = "tf-as-%s"
+  policy_type            = "TargetTrackingScaling"
+  autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
+
+  target_tracking_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "ASGAverageCPUUtilization"
+    }
+
+    target_value = 40.0
+  }
+}
+
+resource "aws_autoscaling_policy" "test_custom" {
+  name                   = "tf-as-%s-custom"
+  policy_type            = "TargetTrackingScaling"
+  autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
+
+  target_tracking_configuration {
+    customized_metric_specification {
+      metric_dimension {
+        name  = "fuga"
+        value = "fuga"
+      }
+
+      metric_name = "hoge"
+      namespace   = "hoge"
+      statistic   = "Average"
+    }
+
+    target_value     = 40.0
+    disable_scale_in = true
+  }
+}
+`, name, name, name, name)
+}
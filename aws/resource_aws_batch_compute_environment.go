@@ -213,7 +213,7 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 			input.ComputeResources.SpotIamFleetRole = aws.String(v.(string))
 		}
 		if v, ok := computeResource["tags"]; ok {
-			input.ComputeResources.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+			input.ComputeResources.Tags = tagsFromMapGeneric(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 		}
 	}
 
@@ -267,7 +267,7 @@ func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interfa
 	d.Set("type", computeEnvironment.Type)
 
 	if *(computeEnvironment.Type) == "MANAGED" {
-		d.Set("compute_resources", flattenComputeResources(computeEnvironment.ComputeResources))
+		d.Set("compute_resources", flattenComputeResources(computeEnvironment.ComputeResources, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	d.Set("arn", computeEnvironment.ComputeEnvironmentArn)
@@ -279,7 +279,7 @@ func resourceAwsBatchComputeEnvironmentRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func flattenComputeResources(computeResource *batch.ComputeResource) []map[string]interface{} {
+func flattenComputeResources(computeResource *batch.ComputeResource, ignoreConfig *ignoreTagsConfig) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 	m := make(map[string]interface{})
 
@@ -294,7 +294,7 @@ func flattenComputeResources(computeResource *batch.ComputeResource) []map[strin
 	m["security_group_ids"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.SecurityGroupIds))
 	m["spot_iam_fleet_role"] = computeResource.SpotIamFleetRole
 	m["subnets"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.Subnets))
-	m["tags"] = tagsToMapGeneric(computeResource.Tags)
+	m["tags"] = tagsToMapGeneric(computeResource.Tags, ignoreConfig)
 	m["type"] = computeResource.Type
 
 	result = append(result, m)
@@ -554,7 +554,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               tagsFromMapCloudFront(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 		},
 	}
 
@@ -611,7 +611,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 			d.Id(), d.Get("arn").(string)), err)
 	}
 
-	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+	if err := d.Set("tags_all", tagsToMapCloudFront(tagResp.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -630,7 +630,7 @@ func resourceAwsCloudFrontDistributionUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if err := setTagsCloudFront(conn, d, d.Get("arn").(string)); err != nil {
+	if err := setTagsCloudFront(conn, d, d.Get("arn").(string), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -217,7 +217,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tagsOut.ResourceTagList[0].TagsList
 	}
 
-	if err := d.Set("tags", tagsToMapCloudtrail(tags)); err != nil {
+	if err := d.Set("tags_all", tagsToMapCloudtrail(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -285,8 +285,8 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
-		err := setTagsCloudtrail(conn, d)
+	if d.HasChange("tags_all") {
+		err := setTagsCloudtrail(conn, d, meta.(*AWSClient).ignoreTagsConfig)
 		if err != nil {
 			return err
 		}
@@ -126,7 +126,7 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return err
 		}
-		d.Set("tags", tags)
+		d.Set("tags_all", tags)
 	}
 
 	return nil
@@ -186,8 +186,8 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if !restricted && d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffCloudWatchTags(o, n)
@@ -555,8 +555,8 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
 
@@ -671,7 +671,7 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("creation_date", resp.UserPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", resp.UserPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", resp.UserPool.Name)
-	d.Set("tags", tagsToMapGeneric(resp.UserPool.UserPoolTags))
+	d.Set("tags_all", tagsToMapGeneric(resp.UserPool.UserPoolTags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -800,8 +800,8 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 	}
 
 	log.Printf("[DEBUG] Updating Cognito User Pool: %s", params)
@@ -100,7 +100,7 @@ func resourceAwsCustomerGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Create tags.
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -201,7 +201,7 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 	customerGateway := resp.CustomerGateways[0]
 	d.Set("ip_address", customerGateway.IpAddress)
 	d.Set("type", customerGateway.Type)
-	d.Set("tags", tagsToMap(customerGateway.Tags))
+	d.Set("tags_all", tagsToMap(customerGateway.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	if *customerGateway.BgpAsn != "" {
 		val, err := strconv.ParseInt(*customerGateway.BgpAsn, 0, 0)
@@ -219,11 +219,11 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).ec2conn
 
 	// Update tags if required.
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsCustomerGatewayRead(d, meta)
 }
@@ -357,7 +357,7 @@ func resourceAwsDbInstance() *schema.Resource {
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -798,7 +798,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags_all", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -1074,10 +1074,10 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if arn, err := buildRDSARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
-			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 	d.Partial(false)
@@ -119,7 +119,7 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -211,7 +211,7 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags_all", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
@@ -285,10 +285,10 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if arn, err := buildRDSOptionGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(rdsconn, d, arn); err != nil {
+		if err := setTagsRDS(rdsconn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
-			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
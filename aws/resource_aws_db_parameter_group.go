@@ -88,7 +88,7 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -181,7 +181,7 @@ func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) e
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags_all", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
@@ -237,10 +237,10 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if arn, err := buildRDSPGARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(rdsconn, d, arn); err != nil {
+		if err := setTagsRDS(rdsconn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
-			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
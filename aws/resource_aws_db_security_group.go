@@ -83,7 +83,7 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 	var err error
 	var errs []error
@@ -197,7 +197,7 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		d.Set("tags_all", tagsToMapRDS(dt, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
@@ -208,10 +208,10 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 
 	d.Partial(true)
 	if arn, err := buildRDSSecurityGroupARN(d.Id(), meta.(*AWSClient).partition, meta.(*AWSClient).accountid, meta.(*AWSClient).region); err == nil {
-		if err := setTagsRDS(conn, d, arn); err != nil {
+		if err := setTagsRDS(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		} else {
-			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
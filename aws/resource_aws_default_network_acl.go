@@ -230,10 +230,10 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
-		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
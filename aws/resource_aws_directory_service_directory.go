@@ -399,7 +399,7 @@ func resourceAwsDirectoryServiceDirectoryUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if err := setTagsDS(dsconn, d, d.Id()); err != nil {
+	if err := setTagsDS(dsconn, d, d.Id(), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -460,7 +460,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("Failed to get Directory service tags (id: %s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapDS(tagList.Tags))
+	d.Set("tags_all", tagsToMapDS(tagList.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
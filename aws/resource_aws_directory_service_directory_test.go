@@ -53,9 +53,9 @@ func TestDiffTagsDirectoryService(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsDS(tagsFromMapDS(tc.Old), tagsFromMapDS(tc.New))
-		cm := tagsToMapDS(c)
-		rm := tagsToMapDS(r)
+		c, r := diffTagsDS(tagsFromMapDS(tc.Old, nil), tagsFromMapDS(tc.New, nil), nil)
+		cm := tagsToMapDS(c, nil)
+		rm := tagsToMapDS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
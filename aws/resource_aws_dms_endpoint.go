@@ -127,7 +127,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               dmsTagsFromMap(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 	}
 
 	// if dynamodb then add required params
@@ -214,7 +214,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags_all", dmsTagsToMap(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -284,7 +284,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
 		if err != nil {
 			return err
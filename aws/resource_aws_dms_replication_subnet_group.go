@@ -60,7 +60,7 @@ func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta int
 		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
 		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
 		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
-		Tags:                              dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                              dmsTagsFromMap(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 	}
 
 	log.Println("[DEBUG] DMS create replication subnet group:", request)
@@ -109,7 +109,7 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	d.Set("tags_all", dmsTagsToMap(tagsResp.TagList, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -128,7 +128,7 @@ func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta int
 		request.ReplicationSubnetGroupDescription = aws.String(d.Get("replication_subnet_group_description").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_subnet_group_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxGatewayAssociation_basic(t *testing.T) {
+	resourceName := "aws_dx_gateway_association.test"
+	rName := fmt.Sprintf("tf-dxgwassoc-%s", acctest.RandString(5))
+	rBgpAsn := acctest.RandIntRange(64512, 65534)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxGatewayAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxGatewayAssociationConfig(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxGatewayAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", "aws_dx_gateway.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpn_gateway_id", "aws_vpn_gateway.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxGatewayAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dx_gateway_association" {
+			continue
+		}
+
+		resp, err := conn.DescribeDirectConnectGatewayAssociations(&directconnect.DescribeDirectConnectGatewayAssociationsInput{
+			DirectConnectGatewayId: aws.String(rs.Primary.Attributes["dx_gateway_id"]),
+			VirtualGatewayId:       aws.String(rs.Primary.Attributes["vpn_gateway_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, v := range resp.DirectConnectGatewayAssociations {
+			if aws.StringValue(v.AssociationState) != directconnect.GatewayAssociationStateDisassociated {
+				return fmt.Errorf("[DESTROY ERROR] Direct Connect gateway association (%s) not deleted", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxGatewayAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxGatewayAssociationConfig(rName string, rBgpAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = "%[1]s"
+  amazon_side_asn = "%[2]d"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.255.255.0/28"
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "%[1]s"
+  }
+}
+
+resource "aws_vpn_gateway_attachment" "test" {
+  vpc_id         = "${aws_vpc.test.id}"
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+}
+
+resource "aws_dx_gateway_association" "test" {
+  dx_gateway_id  = "${aws_dx_gateway.test.id}"
+  vpn_gateway_id = "${aws_vpn_gateway_attachment.test.vpn_gateway_id}"
+}
+`, rName, rBgpAsn)
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Hosted virtual interfaces are allocated to this account by the connection
+// owner, so the test needs an existing, not yet confirmed, interface.
+func TestAccAwsDxHostedPrivateVirtualInterfaceAccepter_basic(t *testing.T) {
+	key := "DX_HOSTED_PRIVATE_VIRTUAL_INTERFACE_ID"
+	vifId := os.Getenv(key)
+	if vifId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_hosted_private_virtual_interface_accepter.test"
+	rName := fmt.Sprintf("tf-testacc-hosted-private-vif-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxHostedPrivateVirtualInterfaceAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPrivateVirtualInterfaceAccepterConfig_basic(vifId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", vifId),
+					resource.TestCheckResourceAttr(resourceName, "virtual_interface_id", vifId),
+					resource.TestCheckResourceAttrPair(resourceName, "vpn_gateway_id", "aws_vpn_gateway.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxHostedPrivateVirtualInterfaceAccepterDestroy(s *terraform.State) error {
+	return testAccCheckDxVirtualInterfaceDestroy(s, "aws_dx_hosted_private_virtual_interface_accepter")
+}
+
+func testAccDxHostedPrivateVirtualInterfaceAccepterConfig_basic(vifId, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "%[2]s"
+  }
+}
+
+resource "aws_dx_hosted_private_virtual_interface_accepter" "test" {
+  virtual_interface_id = "%[1]s"
+  vpn_gateway_id       = "${aws_vpn_gateway.test.id}"
+}
+`, vifId, rName)
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDxHostedPublicVirtualInterfaceAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDxHostedPublicVirtualInterfaceAccepterCreate,
+		Read:   resourceAwsDxHostedPublicVirtualInterfaceAccepterRead,
+		Delete: resourceAwsDxHostedPublicVirtualInterfaceAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDxHostedPublicVirtualInterfaceAccepterImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceAwsDxHostedPublicVirtualInterfaceAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vifId := d.Get("virtual_interface_id").(string)
+	req := &directconnect.ConfirmPublicVirtualInterfaceInput{
+		VirtualInterfaceId: aws.String(vifId),
+	}
+
+	log.Printf("[DEBUG] Accepting Direct Connect hosted public virtual interface: %#v", req)
+	_, err := conn.ConfirmPublicVirtualInterface(req)
+	if err != nil {
+		return fmt.Errorf("Error accepting Direct Connect hosted public virtual interface: %s", err)
+	}
+
+	d.SetId(vifId)
+
+	if err := dxVirtualInterfaceWaitUntilAvailable(
+		conn,
+		d.Id(),
+		d.Timeout(schema.TimeoutCreate),
+		[]string{
+			directconnect.VirtualInterfaceStateConfirming,
+			directconnect.VirtualInterfaceStatePending,
+			directconnect.VirtualInterfaceStateVerifying,
+		},
+		[]string{
+			directconnect.VirtualInterfaceStateAvailable,
+			directconnect.VirtualInterfaceStateDown,
+		}); err != nil {
+		return err
+	}
+
+	return resourceAwsDxHostedPublicVirtualInterfaceAccepterRead(d, meta)
+}
+
+func resourceAwsDxHostedPublicVirtualInterfaceAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dxconn
+
+	vif, err := dxVirtualInterfaceRead(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+	if vif == nil {
+		log.Printf("[WARN] Direct Connect virtual interface (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	vifState := aws.StringValue(vif.VirtualInterfaceState)
+	if vifState != directconnect.VirtualInterfaceStateAvailable &&
+		vifState != directconnect.VirtualInterfaceStateDown {
+		log.Printf("[WARN] Direct Connect virtual interface (%s) is '%s', removing from state", d.Id(), vifState)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", dxVirtualInterfaceArn(meta, d.Id()))
+	d.Set("virtual_interface_id", vif.VirtualInterfaceId)
+
+	return nil
+}
+
+func resourceAwsDxHostedPublicVirtualInterfaceAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	return dxVirtualInterfaceDelete(d, meta)
+}
+
+func resourceAwsDxHostedPublicVirtualInterfaceAccepterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("virtual_interface_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
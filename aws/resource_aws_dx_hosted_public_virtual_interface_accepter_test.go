@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Hosted virtual interfaces are allocated to this account by the connection
+// owner, so the test needs an existing, not yet confirmed, interface.
+func TestAccAwsDxHostedPublicVirtualInterfaceAccepter_basic(t *testing.T) {
+	key := "DX_HOSTED_PUBLIC_VIRTUAL_INTERFACE_ID"
+	vifId := os.Getenv(key)
+	if vifId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_hosted_public_virtual_interface_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxHostedPublicVirtualInterfaceAccepterConfig_basic(vifId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", vifId),
+					resource.TestCheckResourceAttr(resourceName, "virtual_interface_id", vifId),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxHostedPublicVirtualInterfaceAccepterDestroy(s *terraform.State) error {
+	return testAccCheckDxVirtualInterfaceDestroy(s, "aws_dx_hosted_public_virtual_interface_accepter")
+}
+
+func testAccDxHostedPublicVirtualInterfaceAccepterConfig_basic(vifId string) string {
+	return fmt.Sprintf(`
+resource "aws_dx_hosted_public_virtual_interface_accepter" "test" {
+  virtual_interface_id = "%s"
+}
+`, vifId)
+}
@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsDxPrivateVirtualInterface_basic(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_private_virtual_interface.test"
+	rName := fmt.Sprintf("tf-testacc-private-vif-%s", acctest.RandString(10))
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxPrivateVirtualInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxPrivateVirtualInterfaceConfig_vpnGateway(connectionId, rName, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxPrivateVirtualInterfaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "connection_id", connectionId),
+					resource.TestCheckResourceAttr(resourceName, "vlan", fmt.Sprintf("%d", vlan)),
+					resource.TestCheckResourceAttr(resourceName, "bgp_asn", fmt.Sprintf("%d", bgpAsn)),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "ipv4"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpn_gateway_id", "aws_vpn_gateway.test", "id"),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile("^arn:[^:]+:directconnect:[^:]+:[0-9]{12}:dxvif/.+$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsDxPrivateVirtualInterface_dxGateway(t *testing.T) {
+	key := "DX_CONNECTION_ID"
+	connectionId := os.Getenv(key)
+	if connectionId == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_dx_private_virtual_interface.test"
+	rName := fmt.Sprintf("tf-testacc-private-vif-%s", acctest.RandString(10))
+	amzAsn := acctest.RandIntRange(64512, 65534)
+	bgpAsn := acctest.RandIntRange(64512, 65534)
+	vlan := acctest.RandIntRange(2049, 4094)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDxPrivateVirtualInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDxPrivateVirtualInterfaceConfig_dxGateway(connectionId, rName, amzAsn, bgpAsn, vlan),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDxPrivateVirtualInterfaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", "aws_dx_gateway.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDxPrivateVirtualInterfaceDestroy(s *terraform.State) error {
+	return testAccCheckDxVirtualInterfaceDestroy(s, "aws_dx_private_virtual_interface")
+}
+
+func testAccCheckDxVirtualInterfaceDestroy(s *terraform.State, t string) error {
+	conn := testAccProvider.Meta().(*AWSClient).dxconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != t {
+			continue
+		}
+
+		resp, err := conn.DescribeVirtualInterfaces(&directconnect.DescribeVirtualInterfacesInput{
+			VirtualInterfaceId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, "DirectConnectClientException", "does not exist") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, v := range resp.VirtualInterfaces {
+			if aws.StringValue(v.VirtualInterfaceId) == rs.Primary.ID && aws.StringValue(v.VirtualInterfaceState) != directconnect.VirtualInterfaceStateDeleted {
+				return fmt.Errorf("[DESTROY ERROR] Direct Connect virtual interface (%s) not deleted", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckAwsDxPrivateVirtualInterfaceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDxPrivateVirtualInterfaceConfig_vpnGateway(cid, rName string, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags {
+    Name = "%[2]s"
+  }
+}
+
+resource "aws_dx_private_virtual_interface" "test" {
+  connection_id = "%[1]s"
+
+  vpn_gateway_id = "${aws_vpn_gateway.test.id}"
+  name           = "%[2]s"
+  vlan           = %[4]d
+  address_family = "ipv4"
+  bgp_asn        = %[3]d
+}
+`, cid, rName, bgpAsn, vlan)
+}
+
+func testAccDxPrivateVirtualInterfaceConfig_dxGateway(cid, rName string, amzAsn, bgpAsn, vlan int) string {
+	return fmt.Sprintf(`
+resource "aws_dx_gateway" "test" {
+  name            = "%[2]s"
+  amazon_side_asn = "%[3]d"
+}
+
+resource "aws_dx_private_virtual_interface" "test" {
+  connection_id = "%[1]s"
+
+  dx_gateway_id  = "${aws_dx_gateway.test.id}"
+  name           = "%[2]s"
+  vlan           = %[5]d
+  address_family = "ipv4"
+  bgp_asn        = %[4]d
+}
+`, cid, rName, amzAsn, bgpAsn, vlan)
+}
@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbGlobalTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbGlobalTableCreate,
+		Read:   resourceAwsDynamoDbGlobalTableRead,
+		Update: resourceAwsDynamoDbGlobalTableUpdate,
+		Delete: resourceAwsDynamoDbGlobalTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbGlobalTableName,
+			},
+
+			"replica": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	name := d.Get("name").(string)
+	replicas := d.Get("replica").(*schema.Set).List()
+
+	if err := validateAwsDynamoDbGlobalTableReplicaTables(meta, name, replicas); err != nil {
+		return err
+	}
+
+	input := &dynamodb.CreateGlobalTableInput{
+		GlobalTableName:  aws.String(name),
+		ReplicationGroup: expandAwsDynamoDbReplicas(replicas),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Global Table: %#v", input)
+	_, err := conn.CreateGlobalTable(input)
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB Global Table (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	log.Println("[INFO] Waiting for DynamoDB Global Table to be created")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.GlobalTableStatusCreating,
+			dynamodb.GlobalTableStatusDeleting,
+			dynamodb.GlobalTableStatusUpdating,
+		},
+		Target: []string{
+			dynamodb.GlobalTableStatusActive,
+		},
+		Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+func resourceAwsDynamoDbGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
+	globalTableDescription, err := resourceAwsDynamoDbGlobalTableRetrieve(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if globalTableDescription == nil {
+		log.Printf("[WARN] DynamoDB Global Table (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return flattenAwsDynamoDbGlobalTable(d, globalTableDescription)
+}
+
+func resourceAwsDynamoDbGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	if d.HasChange("replica") {
+		o, n := d.GetChange("replica")
+		if o == nil {
+			o = new(schema.Set)
+		}
+		if n == nil {
+			n = new(schema.Set)
+		}
+
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		replicaUpdateCreateReplicas := expandAwsDynamoDbReplicaUpdateCreateReplicas(ns.Difference(os).List())
+		replicaUpdateDeleteReplicas := expandAwsDynamoDbReplicaUpdateDeleteReplicas(os.Difference(ns).List())
+
+		if err := validateAwsDynamoDbGlobalTableReplicaTables(meta, d.Id(), ns.Difference(os).List()); err != nil {
+			return err
+		}
+
+		replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(replicaUpdateCreateReplicas)+len(replicaUpdateDeleteReplicas))
+		replicaUpdates = append(replicaUpdates, replicaUpdateCreateReplicas...)
+		replicaUpdates = append(replicaUpdates, replicaUpdateDeleteReplicas...)
+
+		input := &dynamodb.UpdateGlobalTableInput{
+			GlobalTableName: aws.String(d.Id()),
+			ReplicaUpdates:  replicaUpdates,
+		}
+		log.Printf("[DEBUG] Updating DynamoDB Global Table: %#v", input)
+		if _, err := conn.UpdateGlobalTable(input); err != nil {
+			return fmt.Errorf("Error updating DynamoDB Global Table (%s): %s", d.Id(), err)
+		}
+
+		log.Println("[INFO] Waiting for DynamoDB Global Table to be updated")
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{
+				dynamodb.GlobalTableStatusCreating,
+				dynamodb.GlobalTableStatusDeleting,
+				dynamodb.GlobalTableStatusUpdating,
+			},
+			Target: []string{
+				dynamodb.GlobalTableStatusActive,
+			},
+			Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+		}
+		_, err := stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsDynamoDbGlobalTableRead(d, meta)
+}
+
+// Deleting a DynamoDB Global Table is represented by removing all replicas.
+func resourceAwsDynamoDbGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.UpdateGlobalTableInput{
+		GlobalTableName: aws.String(d.Id()),
+		ReplicaUpdates:  expandAwsDynamoDbReplicaUpdateDeleteReplicas(d.Get("replica").(*schema.Set).List()),
+	}
+	log.Printf("[DEBUG] Deleting DynamoDB Global Table: %#v", input)
+	if _, err := conn.UpdateGlobalTable(input); err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB Global Table (%s): %s", d.Id(), err)
+	}
+
+	log.Println("[INFO] Waiting for DynamoDB Global Table to be destroyed")
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			dynamodb.GlobalTableStatusActive,
+			dynamodb.GlobalTableStatusCreating,
+			dynamodb.GlobalTableStatusDeleting,
+			dynamodb.GlobalTableStatusUpdating,
+		},
+		Target:     []string{},
+		Refresh:    resourceAwsDynamoDbGlobalTableStateRefreshFunc(d, meta),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Global Table (%s) to be destroyed: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbGlobalTableRetrieve(d *schema.ResourceData, meta interface{}) (*dynamodb.GlobalTableDescription, error) {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.DescribeGlobalTableInput{
+		GlobalTableName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Retrieving DynamoDB Global Table: %#v", input)
+
+	output, err := conn.DescribeGlobalTable(input)
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving DynamoDB Global Table (%s): %s", d.Id(), err)
+	}
+
+	return output.GlobalTableDescription, nil
+}
+
+func resourceAwsDynamoDbGlobalTableStateRefreshFunc(
+	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gtd, err := resourceAwsDynamoDbGlobalTableRetrieve(d, meta)
+
+		if err != nil {
+			log.Printf("Error on retrieving DynamoDB Global Table when waiting: %s", err)
+			return nil, "", err
+		}
+
+		if gtd == nil {
+			return nil, "", nil
+		}
+
+		if gtd.GlobalTableStatus != nil {
+			log.Printf("[DEBUG] Status for DynamoDB Global Table %s: %s", d.Id(), *gtd.GlobalTableStatus)
+		}
+
+		return gtd, *gtd.GlobalTableStatus, nil
+	}
+}
+
+// validateAwsDynamoDbGlobalTableReplicaTables checks that the regional table
+// backing each replica exists and has DynamoDB Streams enabled with the
+// NEW_AND_OLD_IMAGES view type, which is required to join a Global Table.
+func validateAwsDynamoDbGlobalTableReplicaTables(meta interface{}, name string, replicas []interface{}) error {
+	client := meta.(*AWSClient)
+
+	for _, replicaRaw := range replicas {
+		region := replicaRaw.(map[string]interface{})["region_name"].(string)
+
+		conn := client.dynamodbconn
+		if region != client.region {
+			// Use the default endpoint for other regions; a custom
+			// endpoint only applies to the provider's own region.
+			sess, err := session.NewSession(client.dynamodbconn.Config.Copy(&aws.Config{
+				Region:   aws.String(region),
+				Endpoint: aws.String(""),
+			}))
+			if err != nil {
+				return fmt.Errorf("Error creating DynamoDB session for region %s: %s", region, err)
+			}
+			conn = dynamodb.New(sess)
+		}
+
+		output, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
+			TableName: aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+				return fmt.Errorf("DynamoDB table %q not found in region %s, it must exist before being added to a Global Table", name, region)
+			}
+			return fmt.Errorf("Error reading DynamoDB table %q in region %s: %s", name, region, err)
+		}
+
+		spec := output.Table.StreamSpecification
+		if spec == nil || !aws.BoolValue(spec.StreamEnabled) || aws.StringValue(spec.StreamViewType) != dynamodb.StreamViewTypeNewAndOldImages {
+			return fmt.Errorf("DynamoDB table %q in region %s must have streams enabled with stream_view_type %s to be added to a Global Table",
+				name, region, dynamodb.StreamViewTypeNewAndOldImages)
+		}
+	}
+
+	return nil
+}
+
+func flattenAwsDynamoDbGlobalTable(d *schema.ResourceData, globalTableDescription *dynamodb.GlobalTableDescription) error {
+	var err error
+
+	d.Set("arn", globalTableDescription.GlobalTableArn)
+	d.Set("name", globalTableDescription.GlobalTableName)
+
+	replicas := flattenAwsDynamoDbReplicas(globalTableDescription.ReplicationGroup)
+	err = d.Set("replica", replicas)
+	return err
+}
+
+func expandAwsDynamoDbReplicaUpdateCreateReplicas(configuredReplicas []interface{}) []*dynamodb.ReplicaUpdate {
+	replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+			Create: &dynamodb.CreateReplicaAction{
+				RegionName: aws.String(replica["region_name"].(string)),
+			},
+		})
+	}
+	return replicaUpdates
+}
+
+func expandAwsDynamoDbReplicaUpdateDeleteReplicas(configuredReplicas []interface{}) []*dynamodb.ReplicaUpdate {
+	replicaUpdates := make([]*dynamodb.ReplicaUpdate, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicaUpdates = append(replicaUpdates, &dynamodb.ReplicaUpdate{
+			Delete: &dynamodb.DeleteReplicaAction{
+				RegionName: aws.String(replica["region_name"].(string)),
+			},
+		})
+	}
+	return replicaUpdates
+}
+
+func expandAwsDynamoDbReplicas(configuredReplicas []interface{}) []*dynamodb.Replica {
+	replicas := make([]*dynamodb.Replica, 0, len(configuredReplicas))
+	for _, replicaRaw := range configuredReplicas {
+		replica := replicaRaw.(map[string]interface{})
+		replicas = append(replicas, &dynamodb.Replica{
+			RegionName: aws.String(replica["region_name"].(string)),
+		})
+	}
+	return replicas
+}
+
+func flattenAwsDynamoDbReplicas(replicaDescriptions []*dynamodb.ReplicaDescription) []interface{} {
+	replicas := []interface{}{}
+	for _, replicaDescription := range replicaDescriptions {
+		replicas = append(replicas, map[string]interface{}{
+			"region_name": aws.StringValue(replicaDescription.RegionName),
+		})
+	}
+	return replicas
+}
@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbGlobalTable_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_global_table.test"
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbGlobalTableConfig_basic(tableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbGlobalTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", tableName),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+					resource.TestMatchResourceAttr(resourceName, "arn",
+						regexp.MustCompile("^arn:aws:dynamodb::[0-9]{12}:global-table/[a-z0-9-]+$")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbGlobalTable_streamsRequired(t *testing.T) {
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbGlobalTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDynamoDbGlobalTableConfig_noStreams(tableName),
+				ExpectError: regexp.MustCompile("must have streams enabled with stream_view_type NEW_AND_OLD_IMAGES"),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDynamoDbGlobalTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_global_table" {
+			continue
+		}
+
+		input := &dynamodb.DescribeGlobalTableInput{
+			GlobalTableName: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.DescribeGlobalTable(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeGlobalTableNotFoundException, "") {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Expected DynamoDB Global Table to be destroyed, %s found", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDynamoDbGlobalTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		_, err := conn.DescribeGlobalTable(&dynamodb.DescribeGlobalTableInput{
+			GlobalTableName: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccDynamoDbGlobalTableConfig_basic(tableName string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias  = "us-east-1"
+  region = "us-east-1"
+}
+
+resource "aws_dynamodb_table" "us-west-2" {
+  hash_key         = "myAttribute"
+  name             = "%[1]s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table" "us-east-1" {
+  provider = "aws.us-east-1"
+
+  hash_key         = "myAttribute"
+  name             = "%[1]s"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+  read_capacity    = 1
+  write_capacity   = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.us-west-2", "aws_dynamodb_table.us-east-1"]
+
+  name = "%[1]s"
+
+  replica {
+    region_name = "us-west-2"
+  }
+
+  replica {
+    region_name = "us-east-1"
+  }
+}
+`, tableName)
+}
+
+func testAccDynamoDbGlobalTableConfig_noStreams(tableName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "us-west-2" {
+  hash_key       = "myAttribute"
+  name           = "%[1]s"
+  read_capacity  = 1
+  write_capacity = 1
+
+  attribute {
+    name = "myAttribute"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_global_table" "test" {
+  depends_on = ["aws_dynamodb_table.us-west-2"]
+
+  name = "%[1]s"
+
+  replica {
+    region_name = "us-west-2"
+  }
+}
+`, tableName)
+}
@@ -318,7 +318,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	_, timeToLiveOk := d.GetOk("ttl")
-	_, tagsOk := d.GetOk("tags")
+	_, tagsOk := d.GetOk("tags_all")
 
 	attemptCount := 1
 	for attemptCount <= DYNAMODB_MAX_THROTTLE_RETRIES {
@@ -641,7 +641,7 @@ func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	// Update tags
-	if err := setTagsDynamoDb(dynamodbconn, d); err != nil {
+	if err := setTagsDynamoDb(dynamodbconn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -837,7 +837,7 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, meta interface{}, t
 		return err
 	}
 	if len(tags) != 0 {
-		d.Set("tags", tags)
+		d.Set(tagsKey(d), tags)
 	}
 
 	return nil
@@ -1069,12 +1069,12 @@ func createTableTags(d *schema.ResourceData, meta interface{}) error {
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return err
 	}
-	tags := d.Get("tags").(map[string]interface{})
+	tags := d.Get("tags_all").(map[string]interface{})
 	arn := d.Get("arn").(string)
 	dynamodbconn := meta.(*AWSClient).dynamodbconn
 	req := &dynamodb.TagResourceInput{
 		ResourceArn: aws.String(arn),
-		Tags:        tagsFromMapDynamoDb(tags),
+		Tags:        tagsFromMapDynamoDb(tags, meta.(*AWSClient).ignoreTagsConfig),
 	}
 	_, err := dynamodbconn.TagResource(req)
 	if err != nil {
@@ -1099,7 +1099,7 @@ func readTableTags(d *schema.ResourceData, meta interface{}) (map[string]string,
 	if err != nil {
 		return nil, fmt.Errorf("Error reading tags from dynamodb resource: %s", err)
 	}
-	result := tagsToMapDynamoDb(output.Tags)
+	result := tagsToMapDynamoDb(output.Tags, meta.(*AWSClient).ignoreTagsConfig)
 	// TODO Read NextToken if avail
 	return result, nil
 }
@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbBackupName,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.CreateBackupInput{
+		TableName:  aws.String(d.Get("table_name").(string)),
+		BackupName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table Backup: %#v", input)
+	var output *dynamodb.CreateBackupOutput
+	// Backups cannot be requested while the table is still being created or updated.
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackup(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") ||
+				isAWSErr(err, dynamodb.ErrCodeResourceInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DynamoDB Table Backup: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	log.Println("[INFO] Waiting for DynamoDB Table Backup to be available")
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.BackupStatusCreating},
+		Target:     []string{dynamodb.BackupStatusAvailable},
+		Refresh:    resourceAwsDynamoDbTableBackupStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Table Backup (%s) to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB Table Backup (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading DynamoDB Table Backup (%s): %s", d.Id(), err)
+	}
+
+	desc := output.BackupDescription
+	if desc == nil || desc.BackupDetails == nil || aws.StringValue(desc.BackupDetails.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB Table Backup (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	details := desc.BackupDetails
+	d.Set("arn", details.BackupArn)
+	d.Set("name", details.BackupName)
+	d.Set("status", details.BackupStatus)
+	d.Set("size_bytes", details.BackupSizeBytes)
+	if details.BackupCreationDateTime != nil {
+		d.Set("creation_date_time", details.BackupCreationDateTime.Format(time.RFC3339))
+	}
+	if desc.SourceTableDetails != nil {
+		d.Set("table_name", desc.SourceTableDetails.TableName)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Backup: %s", d.Id())
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+			BackupArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting DynamoDB Table Backup (%s): %s", d.Id(), err)
+	}
+
+	log.Println("[INFO] Waiting for DynamoDB Table Backup to be deleted")
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.BackupStatusAvailable, dynamodb.BackupStatusCreating},
+		Target:     []string{dynamodb.BackupStatusDeleted},
+		Refresh:    resourceAwsDynamoDbTableBackupStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for DynamoDB Table Backup (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupStateRefreshFunc(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(arn),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				return "", dynamodb.BackupStatusDeleted, nil
+			}
+			return nil, "", err
+		}
+
+		if output.BackupDescription == nil || output.BackupDescription.BackupDetails == nil {
+			return "", dynamodb.BackupStatusDeleted, nil
+		}
+
+		details := output.BackupDescription.BackupDetails
+		return details, aws.StringValue(details.BackupStatus), nil
+	}
+}
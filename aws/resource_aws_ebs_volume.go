@@ -139,8 +139,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
-		if err := setTags(conn, d); err != nil {
+	if _, ok := d.GetOk("tags_all"); ok {
+		if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 		}
 	}
@@ -150,8 +150,8 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
-		if err := setTags(conn, d); err != nil {
+	if _, ok := d.GetOk("tags_all"); ok {
+		if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
 	}
@@ -243,7 +243,7 @@ func resourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error reading EC2 volume %s: %s", d.Id(), err)
 	}
 
-	return readVolume(d, meta.(*AWSClient), response.Volumes[0])
+	return readVolume(d, meta.(*AWSClient), response.Volumes[0], meta.(*AWSClient).ignoreTagsConfig)
 }
 
 func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error {
@@ -272,7 +272,7 @@ func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error
 
 }
 
-func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) error {
+func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume, ignoreConfig *ignoreTagsConfig) error {
 	d.SetId(*volume.VolumeId)
 
 	arn := arn.ARN{
@@ -311,7 +311,7 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		}
 	}
 
-	d.Set("tags", tagsToMap(volume.Tags))
+	d.Set("tags_all", tagsToMap(volume.Tags, ignoreConfig))
 
 	return nil
 }
@@ -157,7 +157,7 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).efsconn
-	err := setTagsEFS(conn, d)
+	err := setTagsEFS(conn, d, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
 			d.Id(), err.Error())
@@ -212,7 +212,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = d.Set("tags_all", tagsToMapEFS(tags, meta.(*AWSClient).ignoreTagsConfig))
 	if err != nil {
 		return err
 	}
@@ -234,7 +234,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 	securityNames := expandStringList(securityNameSet.List())
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 	req := &elasticache.CreateCacheClusterInput{
 		CacheClusterId:          aws.String(clusterId),
@@ -406,7 +406,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 			if len(resp.TagList) > 0 {
 				et = resp.TagList
 			}
-			d.Set("tags", tagsToMapEC(et))
+			d.Set("tags_all", tagsToMapEC(et, meta.(*AWSClient).ignoreTagsConfig))
 		}
 	}
 
@@ -419,7 +419,7 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for ElastiCache Cluster, not updating Tags for cluster %s", d.Id())
 	} else {
-		if err := setTagsEC(conn, d, arn); err != nil {
+		if err := setTagsEC(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		}
 	}
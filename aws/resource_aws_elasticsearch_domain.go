@@ -383,14 +383,14 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
-	if err := setTagsElasticsearchService(conn, d, *out.DomainStatus.ARN); err != nil {
+	if err := setTagsElasticsearchService(conn, d, *out.DomainStatus.ARN, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(tags))
-	d.SetPartial("tags")
+	d.Set("tags_all", tagsToMapElasticsearchService(tags, meta.(*AWSClient).ignoreTagsConfig))
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -518,7 +518,7 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		est = listOut.TagList
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(est))
+	d.Set("tags_all", tagsToMapElasticsearchService(est, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -528,11 +528,11 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(true)
 
-	if err := setTagsElasticsearchService(conn, d, d.Id()); err != nil {
+	if err := setTagsElasticsearchService(conn, d, d.Id(), meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
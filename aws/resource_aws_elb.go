@@ -279,7 +279,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", elbName)
 	}
 
-	tags := tagsFromMapELB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapELB(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 	// Provision the elb
 	elbOpts := &elb.CreateLoadBalancerInput{
 		LoadBalancerName: aws.String(elbName),
@@ -337,7 +337,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("security_groups")
 	d.SetPartial("subnets")
 
-	d.Set("tags", tagsToMapELB(tags))
+	d.Set("tags_all", tagsToMapELB(tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return resourceAwsElbUpdate(d, meta)
 }
@@ -374,11 +374,11 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to find ELB: %#v", describeResp.LoadBalancerDescriptions)
 	}
 
-	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, describeResp.LoadBalancerDescriptions[0])
+	return flattenAwsELbResource(d, meta.(*AWSClient).ec2conn, elbconn, describeResp.LoadBalancerDescriptions[0], meta.(*AWSClient).ignoreTagsConfig)
 }
 
 // flattenAwsELbResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
-func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *elb.ELB, lb *elb.LoadBalancerDescription) error {
+func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *elb.ELB, lb *elb.LoadBalancerDescription, ignoreConfig *ignoreTagsConfig) error {
 	describeAttrsOpts := &elb.DescribeLoadBalancerAttributesInput{
 		LoadBalancerName: aws.String(d.Id()),
 	}
@@ -461,7 +461,7 @@ func flattenAwsELbResource(d *schema.ResourceData, ec2conn *ec2.EC2, elbconn *el
 	if len(resp.TagDescriptions) > 0 {
 		et = resp.TagDescriptions[0].Tags
 	}
-	d.Set("tags", tagsToMapELB(et))
+	d.Set(tagsKey(d), tagsToMapELB(et, ignoreConfig))
 
 	// There's only one health check, so save that to state as we
 	// currently can
@@ -792,11 +792,11 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("subnets")
 	}
 
-	if err := setTagsELB(elbconn, d); err != nil {
+	if err := setTagsELB(elbconn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	return resourceAwsElbRead(d, meta)
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCatalogDatabase_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "aws_glue_catalog_database.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogDatabaseConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogDatabaseConfig_full(rName, "A test catalog from terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "A test catalog from terraform"),
+					resource.TestCheckResourceAttr(resourceName, "location_uri", "my-location"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param2", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param3", "50"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogDatabaseConfig_full(rName, "An updated test catalog from terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "An updated test catalog from terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCatalogDatabaseDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_database" {
+			continue
+		}
+
+		catalogID, name, err := readAwsGlueCatalogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Database %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogDatabaseExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, err := readAwsGlueCatalogID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		_, err = conn.GetDatabase(&glue.GetDatabaseInput{
+			CatalogId: aws.String(catalogID),
+			Name:      aws.String(dbName),
+		})
+		return err
+	}
+}
+
+func testAccGlueCatalogDatabaseConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%s"
+}
+`, rName)
+}
+
+func testAccGlueCatalogDatabaseConfig_full(rName, desc string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name         = "%s"
+  description  = "%s"
+  location_uri = "my-location"
+
+  parameters {
+    param1 = "value1"
+    param2 = true
+    param3 = 50
+  }
+}
+`, rName, desc)
+}
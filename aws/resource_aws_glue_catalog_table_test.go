@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueCatalogTable_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "catalog_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueCatalogTable_full(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(5))
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGlueCatalogTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueCatalogTableConfig_full(rName, "A test table from terraform", "my_column_1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "A test table from terraform"),
+					resource.TestCheckResourceAttr(resourceName, "owner", "my_owner"),
+					resource.TestCheckResourceAttr(resourceName, "retention", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_type", "VIRTUAL_VIEW"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.param1", "param1_val"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.type", "int"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.type", "int"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.comment", "my_column1_comment"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "my_location"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.compressed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.number_of_buckets", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.name", "ser_de_name"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.parameters.param1", "param_val_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.serialization_library", "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.bucket_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.0.column", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.sort_columns.0.sort_order", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.skewed_info.0.skewed_column_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.skewed_info.0.skewed_column_value_location_maps.my_column_1", "my_column_1_val_loc_map"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.stored_as_sub_directories", "false"),
+				),
+			},
+			{
+				Config: testAccGlueCatalogTableConfig_full(rName, "An updated test table from terraform", "my_column_updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlueCatalogTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "An updated test table from terraform"),
+					resource.TestCheckResourceAttr(resourceName, "partition_keys.0.name", "my_column_updated"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlueCatalogTableDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_catalog_table" {
+			continue
+		}
+
+		catalogID, dbName, name, err := readAwsGlueTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("Glue Catalog Table %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGlueCatalogTableExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, tableName, err := readAwsGlueTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		_, err = conn.GetTable(&glue.GetTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(tableName),
+		})
+		return err
+	}
+}
+
+func testAccGlueCatalogTableConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = "%[1]s"
+  database_name = "${aws_glue_catalog_database.test.name}"
+}
+`, rName)
+}
+
+func testAccGlueCatalogTableConfig_full(rName, desc, columnName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "%[1]s"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name               = "%[1]s"
+  database_name      = "${aws_glue_catalog_database.test.name}"
+  description        = "%[2]s"
+  owner              = "my_owner"
+  retention          = 1
+  table_type         = "VIRTUAL_VIEW"
+  view_expanded_text = "view_expanded_text_1"
+  view_original_text = "view_original_text_1"
+
+  storage_descriptor {
+    bucket_columns            = ["bucket_column_1"]
+    compressed                = false
+    input_format              = "SequenceFileInputFormat"
+    location                  = "my_location"
+    number_of_buckets         = 1
+    output_format             = "SequenceFileInputFormat"
+    stored_as_sub_directories = false
+
+    parameters {
+      param1 = "param1_val"
+    }
+
+    columns = [
+      {
+        name    = "%[3]s"
+        type    = "int"
+        comment = "my_column1_comment"
+      },
+      {
+        name    = "my_column_2"
+        type    = "string"
+        comment = "my_column2_comment"
+      },
+    ]
+
+    ser_de_info {
+      name = "ser_de_name"
+
+      parameters {
+        param1 = "param_val_1"
+      }
+
+      serialization_library = "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"
+    }
+
+    sort_columns = [
+      {
+        column     = "%[3]s"
+        sort_order = 1
+      },
+    ]
+
+    skewed_info {
+      skewed_column_names = [
+        "%[3]s",
+      ]
+
+      skewed_column_value_location_maps {
+        %[3]s = "my_column_1_val_loc_map"
+      }
+
+      skewed_column_values = [
+        "skewed_val_1",
+      ]
+    }
+  }
+
+  partition_keys = [
+    {
+      name    = "%[3]s"
+      type    = "int"
+      comment = "my_column_1_comment"
+    },
+  ]
+
+  parameters {
+    param1 = "param1_val"
+  }
+}
+`, rName, desc, columnName)
+}
@@ -0,0 +1,406 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueCrawler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueCrawlerCreate,
+		Read:   resourceAwsGlueCrawlerRead,
+		Update: resourceAwsGlueCrawlerUpdate,
+		Delete: resourceAwsGlueCrawlerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"classifiers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schema_change_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.DeleteBehaviorDeprecateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.DeleteBehaviorDeleteFromDatabase,
+								glue.DeleteBehaviorDeprecateInDatabase,
+								glue.DeleteBehaviorLog,
+							}, false),
+						},
+						"update_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  glue.UpdateBehaviorUpdateInDatabase,
+							ValidateFunc: validation.StringInSlice([]string{
+								glue.UpdateBehaviorLog,
+								glue.UpdateBehaviorUpdateInDatabase,
+							}, false),
+						},
+					},
+				},
+			},
+			"table_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"s3_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"jdbc_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := normalizeJsonString(v)
+					return json
+				},
+				ValidateFunc: validateJsonString,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input, err := createGlueCrawlerInput(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Glue Crawler: %s", input)
+	// The role may not be assumable by Glue until it has propagated
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateCrawler(input)
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Glue Crawler (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.GetCrawlerInput{
+		Name: aws.String(d.Id()),
+	}
+
+	out, err := conn.GetCrawler(input)
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	crawler := out.Crawler
+	d.Set("name", crawler.Name)
+	d.Set("database_name", crawler.DatabaseName)
+	d.Set("role", crawler.Role)
+	d.Set("description", crawler.Description)
+	d.Set("table_prefix", crawler.TablePrefix)
+	d.Set("configuration", crawler.Configuration)
+
+	d.Set("schedule", "")
+	if crawler.Schedule != nil {
+		d.Set("schedule", crawler.Schedule.ScheduleExpression)
+	}
+
+	if err := d.Set("classifiers", flattenStringList(crawler.Classifiers)); err != nil {
+		return fmt.Errorf("Error setting classifiers: %s", err)
+	}
+	if err := d.Set("schema_change_policy", flattenGlueCrawlerSchemaChangePolicy(crawler.SchemaChangePolicy)); err != nil {
+		return fmt.Errorf("Error setting schema_change_policy: %s", err)
+	}
+
+	if crawler.Targets != nil {
+		if err := d.Set("s3_target", flattenGlueS3Targets(crawler.Targets.S3Targets)); err != nil {
+			return fmt.Errorf("Error setting s3_target: %s", err)
+		}
+		if err := d.Set("jdbc_target", flattenGlueJdbcTargets(crawler.Targets.JdbcTargets)); err != nil {
+			return fmt.Errorf("Error setting jdbc_target: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsGlueCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input, err := updateGlueCrawlerInput(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Glue Crawler: %s", input)
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.UpdateCrawler(input)
+		if err != nil {
+			if isAWSErr(err, glue.ErrCodeInvalidInputException, "Service is unable to assume role") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGlueCrawlerRead(d, meta)
+}
+
+func resourceAwsGlueCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Crawler: %s", d.Id())
+	_, err := conn.DeleteCrawler(&glue.DeleteCrawlerInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Glue Crawler (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createGlueCrawlerInput(d *schema.ResourceData) (*glue.CreateCrawlerInput, error) {
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &glue.CreateCrawlerInput{
+		Name:         aws.String(d.Get("name").(string)),
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		Role:         aws.String(d.Get("role").(string)),
+		Targets:      targets,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("schedule"); ok {
+		input.Schedule = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("classifiers"); ok {
+		input.Classifiers = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("schema_change_policy"); ok {
+		input.SchemaChangePolicy = expandGlueCrawlerSchemaChangePolicy(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("table_prefix"); ok {
+		input.TablePrefix = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+
+	return input, nil
+}
+
+func updateGlueCrawlerInput(d *schema.ResourceData) (*glue.UpdateCrawlerInput, error) {
+	targets, err := expandGlueCrawlerTargets(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike create, empty values are sent so that removing an attribute
+	// from the configuration clears it on the crawler.
+	input := &glue.UpdateCrawlerInput{
+		Name:         aws.String(d.Get("name").(string)),
+		DatabaseName: aws.String(d.Get("database_name").(string)),
+		Role:         aws.String(d.Get("role").(string)),
+		Targets:      targets,
+		Description:  aws.String(d.Get("description").(string)),
+		Schedule:     aws.String(d.Get("schedule").(string)),
+		Classifiers:  expandStringList(d.Get("classifiers").([]interface{})),
+		TablePrefix:  aws.String(d.Get("table_prefix").(string)),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok {
+		input.Configuration = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("schema_change_policy"); ok {
+		input.SchemaChangePolicy = expandGlueCrawlerSchemaChangePolicy(v.([]interface{}))
+	}
+
+	return input, nil
+}
+
+func expandGlueCrawlerTargets(d *schema.ResourceData) (*glue.CrawlerTargets, error) {
+	s3Targets := d.Get("s3_target").([]interface{})
+	jdbcTargets := d.Get("jdbc_target").([]interface{})
+	if len(s3Targets) == 0 && len(jdbcTargets) == 0 {
+		return nil, fmt.Errorf("One of s3_target or jdbc_target must be set")
+	}
+
+	return &glue.CrawlerTargets{
+		S3Targets:   expandGlueS3Targets(s3Targets),
+		JdbcTargets: expandGlueJdbcTargets(jdbcTargets),
+	}, nil
+}
+
+func expandGlueS3Targets(targets []interface{}) []*glue.S3Target {
+	s3Targets := make([]*glue.S3Target, 0, len(targets))
+	for _, t := range targets {
+		m := t.(map[string]interface{})
+		s3Targets = append(s3Targets, &glue.S3Target{
+			Path:       aws.String(m["path"].(string)),
+			Exclusions: expandStringList(m["exclusions"].([]interface{})),
+		})
+	}
+	return s3Targets
+}
+
+func expandGlueJdbcTargets(targets []interface{}) []*glue.JdbcTarget {
+	jdbcTargets := make([]*glue.JdbcTarget, 0, len(targets))
+	for _, t := range targets {
+		m := t.(map[string]interface{})
+		jdbcTargets = append(jdbcTargets, &glue.JdbcTarget{
+			ConnectionName: aws.String(m["connection_name"].(string)),
+			Path:           aws.String(m["path"].(string)),
+			Exclusions:     expandStringList(m["exclusions"].([]interface{})),
+		})
+	}
+	return jdbcTargets
+}
+
+func expandGlueCrawlerSchemaChangePolicy(l []interface{}) *glue.SchemaChangePolicy {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &glue.SchemaChangePolicy{
+		DeleteBehavior: aws.String(m["delete_behavior"].(string)),
+		UpdateBehavior: aws.String(m["update_behavior"].(string)),
+	}
+}
+
+func flattenGlueS3Targets(s3Targets []*glue.S3Target) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(s3Targets))
+	for _, t := range s3Targets {
+		result = append(result, map[string]interface{}{
+			"path":       aws.StringValue(t.Path),
+			"exclusions": flattenStringList(t.Exclusions),
+		})
+	}
+	return result
+}
+
+func flattenGlueJdbcTargets(jdbcTargets []*glue.JdbcTarget) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(jdbcTargets))
+	for _, t := range jdbcTargets {
+		result = append(result, map[string]interface{}{
+			"connection_name": aws.StringValue(t.ConnectionName),
+			"path":            aws.StringValue(t.Path),
+			"exclusions":      flattenStringList(t.Exclusions),
+		})
+	}
+	return result
+}
+
+func flattenGlueCrawlerSchemaChangePolicy(policy *glue.SchemaChangePolicy) []map[string]interface{} {
+	if policy == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"delete_behavior": aws.StringValue(policy.DeleteBehavior),
+		"update_behavior": aws.StringValue(policy.UpdateBehavior),
+	}
+	return []map[string]interface{}{m}
+}
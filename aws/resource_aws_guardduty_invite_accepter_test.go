@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyInviteAccepter_basic(t *testing.T) {
+	resourceName := "aws_guardduty_invite_accepter.test"
+
+	// The member account is configured through a second provider, using
+	// the credentials of the named profile.
+	profile := os.Getenv("AWS_ALTERNATE_PROFILE")
+	email := os.Getenv("AWS_ALTERNATE_EMAIL")
+	if profile == "" || email == "" {
+		t.Skip("AWS_ALTERNATE_PROFILE and AWS_ALTERNATE_EMAIL must be set to test GuardDuty invitations")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyInviteAccepterConfig_basic(profile, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.member", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_account_id", "aws_guardduty_detector.master", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyInviteAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_member" {
+			continue
+		}
+
+		detectorID, accountID, err := decodeGuardDutyMemberID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		member, err := getGuardDutyMember(conn, detectorID, accountID)
+		if err != nil {
+			if isAWSErr(err, guardduty.ErrCodeBadRequestException, guardDutyDetectorNotFoundMessage) {
+				continue
+			}
+			return err
+		}
+
+		if member != nil && aws.StringValue(member.RelationshipStatus) == "Enabled" {
+			return fmt.Errorf("GuardDuty account %s is still a member of the master account", accountID)
+		}
+	}
+
+	return nil
+}
+
+func testAccGuardDutyInviteAccepterConfig_basic(profile, email string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  alias   = "member"
+  profile = "%s"
+}
+
+resource "aws_guardduty_detector" "master" {}
+
+resource "aws_guardduty_detector" "member" {
+  provider = "aws.member"
+}
+
+resource "aws_guardduty_member" "member" {
+  account_id         = "${aws_guardduty_detector.member.account_id}"
+  detector_id        = "${aws_guardduty_detector.master.id}"
+  email              = "%s"
+  invite             = true
+  invitation_message = "Terraform acceptance test"
+}
+
+resource "aws_guardduty_invite_accepter" "test" {
+  provider          = "aws.member"
+  depends_on        = ["aws_guardduty_member.member"]
+  detector_id       = "${aws_guardduty_detector.member.id}"
+  master_account_id = "${aws_guardduty_detector.master.account_id}"
+}
+`, profile, email)
+}
@@ -0,0 +1,265 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsGuardDutyMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyMemberCreate,
+		Read:   resourceAwsGuardDutyMemberRead,
+		Update: resourceAwsGuardDutyMemberUpdate,
+		Delete: resourceAwsGuardDutyMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"invitation_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	accountID := d.Get("account_id").(string)
+	detectorID := d.Get("detector_id").(string)
+
+	input := &guardduty.CreateMembersInput{
+		AccountDetails: []*guardduty.AccountDetail{{
+			AccountId: aws.String(accountID),
+			Email:     aws.String(d.Get("email").(string)),
+		}},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Member: %s", input)
+	resp, err := conn.CreateMembers(input)
+	if err != nil {
+		return fmt.Errorf("Error creating GuardDuty Member: %s", err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error creating GuardDuty Member: %s", aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, accountID))
+
+	if d.Get("invite").(bool) {
+		if err := inviteGuardDutyMember(conn, detectorID, accountID, d.Get("invitation_message").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := getGuardDutyMember(conn, detectorID, accountID)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, guardDutyDetectorNotFoundMessage) {
+			log.Printf("[WARN] GuardDuty detector (%s) not found, removing member (%s) from state", detectorID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading GuardDuty Member (%s): %s", d.Id(), err)
+	}
+	if member == nil {
+		log.Printf("[WARN] GuardDuty Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	status := aws.StringValue(member.RelationshipStatus)
+	d.Set("account_id", member.AccountId)
+	d.Set("detector_id", detectorID)
+	d.Set("email", member.Email)
+	d.Set("relationship_status", status)
+
+	// An invitation is in effect unless the member was never invited, or
+	// left or was removed from the master account.
+	switch status {
+	case "Disabled", "Enabled", "Invited", "EmailVerificationInProgress":
+		d.Set("invite", true)
+	default:
+		d.Set("invite", false)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := inviteGuardDutyMember(conn, detectorID, accountID, d.Get("invitation_message").(string)); err != nil {
+				return err
+			}
+		} else {
+			input := &guardduty.DisassociateMembersInput{
+				AccountIds: []*string{aws.String(accountID)},
+				DetectorId: aws.String(detectorID),
+			}
+
+			log.Printf("[DEBUG] Disassociating GuardDuty Member: %s", input)
+			resp, err := conn.DisassociateMembers(input)
+			if err != nil {
+				return fmt.Errorf("Error disassociating GuardDuty Member (%s): %s", d.Id(), err)
+			}
+			if len(resp.UnprocessedAccounts) > 0 {
+				return fmt.Errorf("Error disassociating GuardDuty Member (%s): %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+			}
+		}
+	}
+
+	return resourceAwsGuardDutyMemberRead(d, meta)
+}
+
+func resourceAwsGuardDutyMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, accountID, err := decodeGuardDutyMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Member: %s", input)
+	resp, err := conn.DeleteMembers(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, guardDutyDetectorNotFoundMessage) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting GuardDuty Member (%s): %s", d.Id(), err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error deleting GuardDuty Member (%s): %s", d.Id(), aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	return nil
+}
+
+// inviteGuardDutyMember sends the invitation to join the master account and
+// waits for it to be on its way to the member account.
+func inviteGuardDutyMember(conn *guardduty.GuardDuty, detectorID, accountID, message string) error {
+	input := &guardduty.InviteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+	if message != "" {
+		input.Message = aws.String(message)
+	}
+
+	log.Printf("[DEBUG] Inviting GuardDuty Member: %s", input)
+	resp, err := conn.InviteMembers(input)
+	if err != nil {
+		return fmt.Errorf("Error inviting GuardDuty Member %s: %s", accountID, err)
+	}
+	if len(resp.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("Error inviting GuardDuty Member %s: %s", accountID, aws.StringValue(resp.UnprocessedAccounts[0].Result))
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Created", "EmailVerificationInProgress"},
+		Target:  []string{"Invited", "Enabled", "Disabled"},
+		Refresh: func() (interface{}, string, error) {
+			member, err := getGuardDutyMember(conn, detectorID, accountID)
+			if err != nil {
+				return nil, "failed", err
+			}
+			if member == nil {
+				return nil, "", nil
+			}
+			return member, aws.StringValue(member.RelationshipStatus), nil
+		},
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for GuardDuty Member %s invitation: %s", accountID, err)
+	}
+
+	return nil
+}
+
+func getGuardDutyMember(conn *guardduty.GuardDuty, detectorID, accountID string) (*guardduty.Member, error) {
+	input := &guardduty.GetMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+		DetectorId: aws.String(detectorID),
+	}
+
+	resp, err := conn.GetMembers(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range resp.Members {
+		if aws.StringValue(member.AccountId) == accountID {
+			return member, nil
+		}
+	}
+	return nil, nil
+}
+
+func decodeGuardDutyMemberID(id string) (detectorID, accountID string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected DETECTORID:ACCOUNTID", id)
+	}
+	return parts[0], parts[1], nil
+}
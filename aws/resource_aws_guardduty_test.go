@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"testing"
+)
+
+// GuardDuty allows a single detector per account and region, so its tests
+// have to run serially.
+func TestAccAWSGuardDuty(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"Detector": {
+			"basic":  testAccAwsGuardDutyDetector_basic,
+			"import": testAccAwsGuardDutyDetector_import,
+		},
+		"InviteAccepter": {
+			"basic": testAccAwsGuardDutyInviteAccepter_basic,
+		},
+		"IPSet": {
+			"basic":  testAccAwsGuardDutyIpset_basic,
+			"import": testAccAwsGuardDutyIpset_import,
+		},
+		"Member": {
+			"basic":  testAccAwsGuardDutyMember_basic,
+			"import": testAccAwsGuardDutyMember_import,
+		},
+		"ThreatIntelSet": {
+			"basic":  testAccAwsGuardDutyThreatintelset_basic,
+			"import": testAccAwsGuardDutyThreatintelset_import,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
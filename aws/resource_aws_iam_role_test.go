@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -138,6 +139,103 @@ func TestAccAWSIAMRole_badJSON(t *testing.T) {
 	})
 }
 
+func TestAWSIAMRole_offline(t *testing.T) {
+	assumeRolePolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+	role := func(description string) string {
+		return fmt.Sprintf(`    <Role>
+      <Path>/</Path>
+      <RoleName>tf-offline-role</RoleName>
+      <RoleId>AROAOFFLINEROLE</RoleId>
+      <Arn>arn:aws:iam::123456789012:role/tf-offline-role</Arn>
+      <CreateDate>2018-01-01T00:00:00Z</CreateDate>
+      <AssumeRolePolicyDocument>%s</AssumeRolePolicyDocument>
+      <Description>%s</Description>
+    </Role>`, url.QueryEscape(assumeRolePolicy), description)
+	}
+
+	server := newAwsOfflineServer(t,
+		&awsOfflineOperation{
+			Service:   "iam",
+			Operation: "CreateRole",
+			Params:    map[string]string{"RoleName": "tf-offline-role", "Path": "/", "Description": "original"},
+			Response:  awsOfflineQueryResponse("CreateRole", role("original")),
+		},
+		&awsOfflineOperation{
+			Service:   "iam",
+			Operation: "GetRole",
+			Params:    map[string]string{"RoleName": "tf-offline-role"},
+			Response:  awsOfflineQueryResponse("GetRole", role("original")),
+		},
+	)
+	defer server.Close()
+
+	var conf iam.GetRoleOutput
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccAWSIAMRoleConfigOffline("original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoleExists("aws_iam_role.role", &conf),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "arn", "arn:aws:iam::123456789012:role/tf-offline-role"),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "unique_id", "AROAOFFLINEROLE"),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "create_date", "2018-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("aws_iam_role.role", "description", "original"),
+				),
+			},
+			{
+				PreConfig: func() {
+					server.Add(
+						&awsOfflineOperation{
+							Service:   "iam",
+							Operation: "UpdateRoleDescription",
+							Params:    map[string]string{"RoleName": "tf-offline-role", "Description": "changed"},
+							Response:  awsOfflineQueryResponse("UpdateRoleDescription", role("changed")),
+						},
+						&awsOfflineOperation{
+							Service:   "iam",
+							Operation: "GetRole",
+							After:     "UpdateRoleDescription",
+							Response:  awsOfflineQueryResponse("GetRole", role("changed")),
+						},
+						&awsOfflineOperation{
+							Service:   "iam",
+							Operation: "ListInstanceProfilesForRole",
+							Params:    map[string]string{"RoleName": "tf-offline-role"},
+							Response: awsOfflineQueryResponse("ListInstanceProfilesForRole",
+								"    <IsTruncated>false</IsTruncated>\n    <InstanceProfiles/>"),
+						},
+						&awsOfflineOperation{
+							Service:   "iam",
+							Operation: "DeleteRole",
+							Params:    map[string]string{"RoleName": "tf-offline-role"},
+							Response:  awsOfflineQueryResponse("DeleteRole", ""),
+						},
+						&awsOfflineOperation{
+							Service:   "iam",
+							Operation: "GetRole",
+							After:     "DeleteRole",
+							Response: awsOfflineQueryError(404, "NoSuchEntity",
+								"The role with name tf-offline-role cannot be found."),
+						},
+					)
+				},
+				Config: server.providerConfig() + testAccAWSIAMRoleConfigOffline("changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_iam_role.role", "description", "changed"),
+					func(*terraform.State) error {
+						if n := server.Calls("iam", "CreateRole"); n != 1 {
+							return fmt.Errorf("Expected the role to be created once, got %d CreateRole calls", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSRoleDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -375,3 +473,27 @@ POLICY
 }
 `, rName)
 }
+
+func testAccAWSIAMRoleConfigOffline(description string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "role" {
+  name        = "tf-offline-role"
+  description = "%s"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+`, description)
+}
@@ -33,7 +33,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 	conn := meta.(*AWSClient).inspectorconn
 
 	resp, err := conn.CreateResourceGroup(&inspector.CreateResourceGroupInput{
-		ResourceGroupTags: tagsFromMapInspector(d.Get("tags").(map[string]interface{})),
+		ResourceGroupTags: tagsFromMapInspector(d.Get("tags").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 	})
 
 	if err != nil {
@@ -466,8 +466,8 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
-			tags := tagsFromMap(v.(map[string]interface{}))
+		if v, ok := d.GetOk("tags_all"); ok {
+			tags := tagsFromMap(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 			spec := &ec2.TagSpecification{
 				ResourceType: aws.String("instance"),
@@ -478,7 +478,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if v, ok := d.GetOk("volume_tags"); ok {
-			tags := tagsFromMap(v.(map[string]interface{}))
+			tags := tagsFromMap(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 
 			spec := &ec2.TagSpecification{
 				ResourceType: aws.String("volume"),
@@ -712,9 +712,9 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	d.Set("tags_all", tagsToMap(instance.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -772,18 +772,18 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
-			if err := setTags(conn, d); err != nil {
+			if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 				return err
 			} else {
-				d.SetPartial("tags")
+				d.SetPartial("tags_all")
 			}
 		}
 	}
 	if d.HasChange("volume_tags") {
 		if !d.IsNewResource() || !restricted {
-			if err := setVolumeTags(conn, d); err != nil {
+			if err := setVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 				return err
 			} else {
 				d.SetPartial("volume_tags")
@@ -1430,7 +1430,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1458,7 +1458,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", tagsToMap(tags, ignoreConfig))
 
 	return nil
 }
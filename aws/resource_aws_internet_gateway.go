@@ -65,7 +65,7 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 		return errwrap.Wrapf("{{err}}", err)
 	}
 
-	err = setTags(conn, d)
+	err = setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig)
 	if err != nil {
 		return err
 	}
@@ -95,7 +95,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	d.Set("tags_all", tagsToMap(ig.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -115,11 +115,11 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	conn := meta.(*AWSClient).ec2conn
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return nil
 }
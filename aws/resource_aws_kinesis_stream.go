@@ -152,11 +152,11 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	conn := meta.(*AWSClient).kinesisconn
 
 	d.Partial(true)
-	if err := setTagsKinesis(conn, d); err != nil {
+	if err := setTagsKinesis(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	if err := updateKinesisShardCount(conn, d); err != nil {
@@ -212,7 +212,7 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving tags for Stream: %s. %s", sn, err)
 	} else {
-		d.Set("tags", tagsToMapKinesis(tagsResp.Tags))
+		d.Set("tags_all", tagsToMapKinesis(tagsResp.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
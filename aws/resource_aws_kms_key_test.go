@@ -288,9 +288,10 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+		client := testAccProvider.Meta().(*AWSClient)
+		conn := client.kmsconn
 
-		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		o, err := retryOnAwsCode(client.retryConfig, "kms", "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: aws.String(rs.Primary.ID),
 			})
@@ -331,8 +331,8 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
-	if v, exists := d.GetOk("tags"); exists {
-		params.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, exists := d.GetOk("tags_all"); exists {
+		params.Tags = tagsFromMapGeneric(v.(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig)
 	}
 
 	// IAM profiles can take ~10 seconds to propagate in AWS:
@@ -416,7 +416,7 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("runtime", function.Runtime)
 	d.Set("timeout", function.Timeout)
 	d.Set("kms_key_arn", function.KMSKeyArn)
-	d.Set("tags", tagsToMapGeneric(getFunctionOutput.Tags))
+	d.Set("tags_all", tagsToMapGeneric(getFunctionOutput.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	config := flattenLambdaVpcConfigResponse(function.VpcConfig)
 	log.Printf("[INFO] Setting Lambda %s VPC config %#v from API", d.Id(), config)
@@ -529,10 +529,10 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 	d.Partial(true)
 
 	arn := d.Get("arn").(string)
-	if tagErr := setTagsLambda(conn, d, arn); tagErr != nil {
+	if tagErr := setTagsLambda(conn, d, arn, meta.(*AWSClient).ignoreTagsConfig); tagErr != nil {
 		return tagErr
 	}
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	configReq := &lambda.UpdateFunctionConfigurationInput{
 		FunctionName: aws.String(d.Id()),
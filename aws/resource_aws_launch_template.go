@@ -0,0 +1,1207 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLaunchTemplateCreate,
+		Read:   resourceAwsLaunchTemplateRead,
+		Update: resourceAwsLaunchTemplateUpdate,
+		Delete: resourceAwsLaunchTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLaunchTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateLaunchTemplateNamePrefix,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.VolumeTypeStandard,
+											ec2.VolumeTypeIo1,
+											ec2.VolumeTypeGp2,
+											ec2.VolumeTypeSc1,
+											ec2.VolumeTypeSt1,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"elastic_gpu_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.name"},
+							ValidateFunc:  validateArn,
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.arn"},
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_market_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.MarketTypeSpot,
+							}, false),
+						},
+						"spot_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_duration_minutes": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"instance_interruption_behavior": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.InstanceInterruptionBehaviorHibernate,
+											ec2.InstanceInterruptionBehaviorStop,
+											ec2.InstanceInterruptionBehaviorTerminate,
+										}, false),
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"spot_instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ec2.SpotInstanceTypeOneTime,
+											ec2.SpotInstanceTypePersistent,
+										}, false),
+									},
+									"valid_until": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validateRFC3339TimeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv6_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"spread_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateBase64Encoded,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// launchTemplateDataKeys lists the arguments which are sent to the API as
+// part of the launch template data. A change to any of them results in
+// a new launch template version.
+var launchTemplateDataKeys = []string{
+	"block_device_mappings",
+	"credit_specification",
+	"description",
+	"disable_api_termination",
+	"ebs_optimized",
+	"elastic_gpu_specifications",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_market_options",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"vpc_security_group_ids",
+	"tag_specifications",
+	"user_data",
+}
+
+func resourceAwsLaunchTemplateCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, k := range launchTemplateDataKeys {
+		if diff.HasChange(k) {
+			return diff.SetNewComputed("latest_version")
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	ltData, err := buildLaunchTemplateData(d, meta.(*AWSClient).ignoreTagsConfig)
+	if err != nil {
+		return err
+	}
+
+	launchTemplateOpts := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: ltData,
+	}
+
+	if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+		launchTemplateOpts.VersionDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Launch Template: %s", launchTemplateOpts)
+	resp, err := conn.CreateLaunchTemplate(launchTemplateOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Launch Template: %s", err)
+	}
+
+	launchTemplate := resp.LaunchTemplate
+	d.SetId(*launchTemplate.LaunchTemplateId)
+
+	log.Printf("[INFO] Launch Template ID: %s", d.Id())
+
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Reading Launch Template %s", d.Id())
+
+	dlt, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(d.Id())},
+	})
+
+	if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") ||
+		isAWSErr(err, "InvalidLaunchTemplateId.Malformed", "") {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error getting Launch Template: %s", err)
+	}
+
+	if len(dlt.LaunchTemplates) == 0 {
+		log.Printf("[WARN] Launch Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if *dlt.LaunchTemplates[0].LaunchTemplateId != d.Id() {
+		return fmt.Errorf("Unable to find Launch Template: %#v", dlt.LaunchTemplates)
+	}
+
+	log.Printf("[DEBUG] Found Launch Template %s", d.Id())
+
+	lt := dlt.LaunchTemplates[0]
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("tags_all", tagsToMap(lt.Tags, meta.(*AWSClient).ignoreTagsConfig))
+
+	ltArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ec2",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("launch-template/%s", d.Id()),
+	}.String()
+	d.Set("arn", ltArn)
+
+	version := strconv.FormatInt(*lt.LatestVersionNumber, 10)
+
+	dltv, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(d.Id()),
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting Launch Template (%s) version %s: %s", d.Id(), version, err)
+	}
+
+	if len(dltv.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("Unable to find Launch Template (%s) version %s", d.Id(), version)
+	}
+
+	log.Printf("[DEBUG] Found Launch Template version %s for %s", version, d.Id())
+
+	ltv := dltv.LaunchTemplateVersions[0]
+	d.Set("description", ltv.VersionDescription)
+
+	ltData := ltv.LaunchTemplateData
+
+	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("ebs_optimized", ltData.EbsOptimized)
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("user_data", ltData.UserData)
+
+	if err := d.Set("security_group_names", aws.StringValueSlice(ltData.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_group_names: %s", err)
+	}
+
+	if err := d.Set("vpc_security_group_ids", aws.StringValueSlice(ltData.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+	}
+
+	if err := d.Set("block_device_mappings", getBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("error setting block_device_mappings: %s", err)
+	}
+
+	if err := d.Set("credit_specification", getCreditSpecification(ltData.CreditSpecification)); err != nil {
+		return fmt.Errorf("error setting credit_specification: %s", err)
+	}
+
+	if err := d.Set("elastic_gpu_specifications", getElasticGpuSpecifications(ltData.ElasticGpuSpecifications)); err != nil {
+		return fmt.Errorf("error setting elastic_gpu_specifications: %s", err)
+	}
+
+	if err := d.Set("iam_instance_profile", getIamInstanceProfile(ltData.IamInstanceProfile)); err != nil {
+		return fmt.Errorf("error setting iam_instance_profile: %s", err)
+	}
+
+	if err := d.Set("instance_market_options", getInstanceMarketOptions(ltData.InstanceMarketOptions)); err != nil {
+		return fmt.Errorf("error setting instance_market_options: %s", err)
+	}
+
+	if err := d.Set("monitoring", getMonitoring(ltData.Monitoring)); err != nil {
+		return fmt.Errorf("error setting monitoring: %s", err)
+	}
+
+	if err := d.Set("network_interfaces", getNetworkInterfaces(ltData.NetworkInterfaces)); err != nil {
+		return fmt.Errorf("error setting network_interfaces: %s", err)
+	}
+
+	if err := d.Set("placement", getPlacement(ltData.Placement)); err != nil {
+		return fmt.Errorf("error setting placement: %s", err)
+	}
+
+	if err := d.Set("tag_specifications", getTagSpecifications(ltData.TagSpecifications, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if launchTemplateDataHasChange(d) {
+		ltData, err := buildLaunchTemplateData(d, meta.(*AWSClient).ignoreTagsConfig)
+		if err != nil {
+			return err
+		}
+
+		launchTemplateVersionOpts := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: ltData,
+		}
+
+		if v, ok := d.GetOk("description"); ok && v.(string) != "" {
+			launchTemplateVersionOpts.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Launch Template version: %s", launchTemplateVersionOpts)
+		resp, err := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
+		if err != nil {
+			return fmt.Errorf("Error creating Launch Template (%s) version: %s", d.Id(), err)
+		}
+
+		log.Printf("[INFO] Created Launch Template (%s) version %d", d.Id(), *resp.LaunchTemplateVersion.VersionNumber)
+	}
+
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
+		return err
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func launchTemplateDataHasChange(d *schema.ResourceData) bool {
+	for _, k := range launchTemplateDataKeys {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Launch Template destroy: %v", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error deleting Launch Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func buildLaunchTemplateData(d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{
+		DisableApiTermination: aws.Bool(d.Get("disable_api_termination").(bool)),
+		EbsOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_names"); ok {
+		opts.SecurityGroups = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		opts.SecurityGroupIds = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("block_device_mappings"); ok {
+		var blockDeviceMappings []*ec2.LaunchTemplateBlockDeviceMappingRequest
+		for _, bdm := range v.([]interface{}) {
+			if bdm == nil {
+				continue
+			}
+			blockDeviceMappings = append(blockDeviceMappings, readBlockDeviceMappingFromConfig(bdm.(map[string]interface{})))
+		}
+		opts.BlockDeviceMappings = blockDeviceMappings
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok {
+		cs := v.([]interface{})
+		if len(cs) > 0 && cs[0] != nil {
+			opts.CreditSpecification = readCreditSpecificationFromConfig(cs[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := d.GetOk("elastic_gpu_specifications"); ok {
+		var elasticGpuSpecifications []*ec2.ElasticGpuSpecification
+		for _, egs := range v.([]interface{}) {
+			if egs == nil {
+				continue
+			}
+			elasticGpuSpecifications = append(elasticGpuSpecifications, &ec2.ElasticGpuSpecification{
+				Type: aws.String(egs.(map[string]interface{})["type"].(string)),
+			})
+		}
+		opts.ElasticGpuSpecifications = elasticGpuSpecifications
+	}
+
+	if v, ok := d.GetOk("iam_instance_profile"); ok {
+		iip := v.([]interface{})
+		if len(iip) > 0 && iip[0] != nil {
+			opts.IamInstanceProfile = readIamInstanceProfileFromConfig(iip[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := d.GetOk("instance_market_options"); ok {
+		imo := v.([]interface{})
+		if len(imo) > 0 && imo[0] != nil {
+			instanceMarketOptions, err := readInstanceMarketOptionsFromConfig(imo[0].(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			opts.InstanceMarketOptions = instanceMarketOptions
+		}
+	}
+
+	if v, ok := d.GetOk("monitoring"); ok {
+		m := v.([]interface{})
+		if len(m) > 0 && m[0] != nil {
+			mData := m[0].(map[string]interface{})
+			opts.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{
+				Enabled: aws.Bool(mData["enabled"].(bool)),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("network_interfaces"); ok {
+		var networkInterfaces []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest
+		for _, ni := range v.([]interface{}) {
+			if ni == nil {
+				continue
+			}
+			networkInterfaces = append(networkInterfaces, readNetworkInterfacesFromConfig(ni.(map[string]interface{})))
+		}
+		opts.NetworkInterfaces = networkInterfaces
+	}
+
+	if v, ok := d.GetOk("placement"); ok {
+		p := v.([]interface{})
+		if len(p) > 0 && p[0] != nil {
+			opts.Placement = readPlacementFromConfig(p[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := d.GetOk("tag_specifications"); ok {
+		var tagSpecifications []*ec2.LaunchTemplateTagSpecificationRequest
+		for _, ts := range v.([]interface{}) {
+			if ts == nil {
+				continue
+			}
+			tsData := ts.(map[string]interface{})
+			tagSpecifications = append(tagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+				ResourceType: aws.String(tsData["resource_type"].(string)),
+				Tags:         tagsFromMap(tsData["tags"].(map[string]interface{}), ignoreConfig),
+			})
+		}
+		opts.TagSpecifications = tagSpecifications
+	}
+
+	return opts, nil
+}
+
+func readBlockDeviceMappingFromConfig(bdm map[string]interface{}) *ec2.LaunchTemplateBlockDeviceMappingRequest {
+	blockDeviceMapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{}
+
+	if v := bdm["device_name"].(string); v != "" {
+		blockDeviceMapping.DeviceName = aws.String(v)
+	}
+
+	if v := bdm["no_device"].(string); v != "" {
+		blockDeviceMapping.NoDevice = aws.String(v)
+	}
+
+	if v := bdm["virtual_name"].(string); v != "" {
+		blockDeviceMapping.VirtualName = aws.String(v)
+	}
+
+	if v := bdm["ebs"].([]interface{}); len(v) > 0 && v[0] != nil {
+		ebs := v[0].(map[string]interface{})
+		ebsRequest := &ec2.LaunchTemplateEbsBlockDeviceRequest{
+			DeleteOnTermination: aws.Bool(ebs["delete_on_termination"].(bool)),
+			Encrypted:           aws.Bool(ebs["encrypted"].(bool)),
+		}
+
+		if v := ebs["iops"].(int); v > 0 {
+			ebsRequest.Iops = aws.Int64(int64(v))
+		}
+
+		if v := ebs["kms_key_id"].(string); v != "" {
+			ebsRequest.KmsKeyId = aws.String(v)
+		}
+
+		if v := ebs["snapshot_id"].(string); v != "" {
+			ebsRequest.SnapshotId = aws.String(v)
+		}
+
+		if v := ebs["volume_size"].(int); v > 0 {
+			ebsRequest.VolumeSize = aws.Int64(int64(v))
+		}
+
+		if v := ebs["volume_type"].(string); v != "" {
+			ebsRequest.VolumeType = aws.String(v)
+		}
+
+		blockDeviceMapping.Ebs = ebsRequest
+	}
+
+	return blockDeviceMapping
+}
+
+func readCreditSpecificationFromConfig(cs map[string]interface{}) *ec2.CreditSpecificationRequest {
+	return &ec2.CreditSpecificationRequest{
+		CpuCredits: aws.String(cs["cpu_credits"].(string)),
+	}
+}
+
+func readIamInstanceProfileFromConfig(iip map[string]interface{}) *ec2.LaunchTemplateIamInstanceProfileSpecificationRequest {
+	iamInstanceProfile := &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+
+	if v := iip["arn"].(string); v != "" {
+		iamInstanceProfile.Arn = aws.String(v)
+	}
+
+	if v := iip["name"].(string); v != "" {
+		iamInstanceProfile.Name = aws.String(v)
+	}
+
+	return iamInstanceProfile
+}
+
+func readInstanceMarketOptionsFromConfig(imo map[string]interface{}) (*ec2.LaunchTemplateInstanceMarketOptionsRequest, error) {
+	instanceMarketOptions := &ec2.LaunchTemplateInstanceMarketOptionsRequest{}
+
+	if v := imo["market_type"].(string); v != "" {
+		instanceMarketOptions.MarketType = aws.String(v)
+	}
+
+	if v := imo["spot_options"].([]interface{}); len(v) > 0 && v[0] != nil {
+		so := v[0].(map[string]interface{})
+		spotOptions := &ec2.LaunchTemplateSpotMarketOptionsRequest{}
+
+		if v := so["block_duration_minutes"].(int); v != 0 {
+			spotOptions.BlockDurationMinutes = aws.Int64(int64(v))
+		}
+
+		if v := so["instance_interruption_behavior"].(string); v != "" {
+			spotOptions.InstanceInterruptionBehavior = aws.String(v)
+		}
+
+		if v := so["max_price"].(string); v != "" {
+			spotOptions.MaxPrice = aws.String(v)
+		}
+
+		if v := so["spot_instance_type"].(string); v != "" {
+			spotOptions.SpotInstanceType = aws.String(v)
+		}
+
+		if v := so["valid_until"].(string); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing launch template spot_options valid_until: %s", err)
+			}
+			spotOptions.ValidUntil = aws.Time(t)
+		}
+
+		instanceMarketOptions.SpotOptions = spotOptions
+	}
+
+	return instanceMarketOptions, nil
+}
+
+func readNetworkInterfacesFromConfig(ni map[string]interface{}) *ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	networkInterface := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+		AssociatePublicIpAddress: aws.Bool(ni["associate_public_ip_address"].(bool)),
+		DeleteOnTermination:      aws.Bool(ni["delete_on_termination"].(bool)),
+		DeviceIndex:              aws.Int64(int64(ni["device_index"].(int))),
+	}
+
+	if v := ni["description"].(string); v != "" {
+		networkInterface.Description = aws.String(v)
+	}
+
+	if v := ni["network_interface_id"].(string); v != "" {
+		networkInterface.NetworkInterfaceId = aws.String(v)
+	}
+
+	if v := ni["subnet_id"].(string); v != "" {
+		networkInterface.SubnetId = aws.String(v)
+	}
+
+	if v := ni["security_groups"].(*schema.Set); v.Len() > 0 {
+		networkInterface.Groups = expandStringList(v.List())
+	}
+
+	if v := ni["ipv6_address_count"].(int); v > 0 {
+		networkInterface.Ipv6AddressCount = aws.Int64(int64(v))
+	}
+
+	if v := ni["ipv6_addresses"].(*schema.Set); v.Len() > 0 {
+		var ipv6Addresses []*ec2.InstanceIpv6AddressRequest
+		for _, address := range v.List() {
+			ipv6Addresses = append(ipv6Addresses, &ec2.InstanceIpv6AddressRequest{
+				Ipv6Address: aws.String(address.(string)),
+			})
+		}
+		networkInterface.Ipv6Addresses = ipv6Addresses
+	}
+
+	if v := ni["private_ip_address"].(string); v != "" {
+		networkInterface.PrivateIpAddress = aws.String(v)
+	}
+
+	if v := ni["ipv4_address_count"].(int); v > 0 {
+		networkInterface.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+	}
+
+	if v := ni["ipv4_addresses"].(*schema.Set); v.Len() > 0 {
+		var privateIpAddresses []*ec2.PrivateIpAddressSpecification
+		for _, address := range v.List() {
+			privateIpAddresses = append(privateIpAddresses, &ec2.PrivateIpAddressSpecification{
+				PrivateIpAddress: aws.String(address.(string)),
+			})
+		}
+		networkInterface.PrivateIpAddresses = privateIpAddresses
+	}
+
+	return networkInterface
+}
+
+func readPlacementFromConfig(p map[string]interface{}) *ec2.LaunchTemplatePlacementRequest {
+	placement := &ec2.LaunchTemplatePlacementRequest{}
+
+	if v := p["affinity"].(string); v != "" {
+		placement.Affinity = aws.String(v)
+	}
+
+	if v := p["availability_zone"].(string); v != "" {
+		placement.AvailabilityZone = aws.String(v)
+	}
+
+	if v := p["group_name"].(string); v != "" {
+		placement.GroupName = aws.String(v)
+	}
+
+	if v := p["host_id"].(string); v != "" {
+		placement.HostId = aws.String(v)
+	}
+
+	if v := p["spread_domain"].(string); v != "" {
+		placement.SpreadDomain = aws.String(v)
+	}
+
+	if v := p["tenancy"].(string); v != "" {
+		placement.Tenancy = aws.String(v)
+	}
+
+	return placement
+}
+
+func getBlockDeviceMappings(m []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
+	s := []interface{}{}
+	for _, v := range m {
+		mapping := map[string]interface{}{
+			"device_name":  aws.StringValue(v.DeviceName),
+			"no_device":    aws.StringValue(v.NoDevice),
+			"virtual_name": aws.StringValue(v.VirtualName),
+		}
+		if v.Ebs != nil {
+			ebs := map[string]interface{}{
+				"delete_on_termination": aws.BoolValue(v.Ebs.DeleteOnTermination),
+				"encrypted":             aws.BoolValue(v.Ebs.Encrypted),
+				"iops":                  int(aws.Int64Value(v.Ebs.Iops)),
+				"kms_key_id":            aws.StringValue(v.Ebs.KmsKeyId),
+				"snapshot_id":           aws.StringValue(v.Ebs.SnapshotId),
+				"volume_size":           int(aws.Int64Value(v.Ebs.VolumeSize)),
+				"volume_type":           aws.StringValue(v.Ebs.VolumeType),
+			}
+			mapping["ebs"] = []interface{}{ebs}
+		}
+		s = append(s, mapping)
+	}
+	return s
+}
+
+func getCreditSpecification(cs *ec2.CreditSpecification) []interface{} {
+	s := []interface{}{}
+	if cs != nil {
+		s = append(s, map[string]interface{}{
+			"cpu_credits": aws.StringValue(cs.CpuCredits),
+		})
+	}
+	return s
+}
+
+func getElasticGpuSpecifications(e []*ec2.ElasticGpuSpecificationResponse) []interface{} {
+	s := []interface{}{}
+	for _, v := range e {
+		s = append(s, map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		})
+	}
+	return s
+}
+
+func getIamInstanceProfile(i *ec2.LaunchTemplateIamInstanceProfileSpecification) []interface{} {
+	s := []interface{}{}
+	if i != nil {
+		s = append(s, map[string]interface{}{
+			"arn":  aws.StringValue(i.Arn),
+			"name": aws.StringValue(i.Name),
+		})
+	}
+	return s
+}
+
+func getInstanceMarketOptions(m *ec2.LaunchTemplateInstanceMarketOptions) []interface{} {
+	s := []interface{}{}
+	if m != nil {
+		mo := map[string]interface{}{
+			"market_type": aws.StringValue(m.MarketType),
+		}
+		if so := m.SpotOptions; so != nil {
+			spotOptions := map[string]interface{}{
+				"block_duration_minutes":         int(aws.Int64Value(so.BlockDurationMinutes)),
+				"instance_interruption_behavior": aws.StringValue(so.InstanceInterruptionBehavior),
+				"max_price":                      aws.StringValue(so.MaxPrice),
+				"spot_instance_type":             aws.StringValue(so.SpotInstanceType),
+			}
+			if so.ValidUntil != nil {
+				spotOptions["valid_until"] = aws.TimeValue(so.ValidUntil).Format(time.RFC3339)
+			}
+			mo["spot_options"] = []interface{}{spotOptions}
+		}
+		s = append(s, mo)
+	}
+	return s
+}
+
+func getMonitoring(m *ec2.LaunchTemplatesMonitoring) []interface{} {
+	s := []interface{}{}
+	if m != nil {
+		s = append(s, map[string]interface{}{
+			"enabled": aws.BoolValue(m.Enabled),
+		})
+	}
+	return s
+}
+
+func getNetworkInterfaces(n []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
+	s := []interface{}{}
+	for _, v := range n {
+		var ipv4Addresses []interface{}
+		var ipv6Addresses []interface{}
+
+		networkInterface := map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(v.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(v.DeleteOnTermination),
+			"description":                 aws.StringValue(v.Description),
+			"device_index":                int(aws.Int64Value(v.DeviceIndex)),
+			"ipv6_address_count":          int(aws.Int64Value(v.Ipv6AddressCount)),
+			"network_interface_id":        aws.StringValue(v.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(v.PrivateIpAddress),
+			"ipv4_address_count":          int(aws.Int64Value(v.SecondaryPrivateIpAddressCount)),
+			"subnet_id":                   aws.StringValue(v.SubnetId),
+		}
+
+		for _, address := range v.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(address.Ipv6Address))
+		}
+		networkInterface["ipv6_addresses"] = schema.NewSet(schema.HashString, ipv6Addresses)
+
+		for _, address := range v.PrivateIpAddresses {
+			ipv4Addresses = append(ipv4Addresses, aws.StringValue(address.PrivateIpAddress))
+		}
+		networkInterface["ipv4_addresses"] = schema.NewSet(schema.HashString, ipv4Addresses)
+
+		networkInterface["security_groups"] = schema.NewSet(schema.HashString, flattenStringList(v.Groups))
+
+		s = append(s, networkInterface)
+	}
+	return s
+}
+
+func getPlacement(p *ec2.LaunchTemplatePlacement) []interface{} {
+	s := []interface{}{}
+	if p != nil {
+		s = append(s, map[string]interface{}{
+			"affinity":          aws.StringValue(p.Affinity),
+			"availability_zone": aws.StringValue(p.AvailabilityZone),
+			"group_name":        aws.StringValue(p.GroupName),
+			"host_id":           aws.StringValue(p.HostId),
+			"spread_domain":     aws.StringValue(p.SpreadDomain),
+			"tenancy":           aws.StringValue(p.Tenancy),
+		})
+	}
+	return s
+}
+
+func getTagSpecifications(t []*ec2.LaunchTemplateTagSpecification, ignoreConfig *ignoreTagsConfig) []interface{} {
+	s := []interface{}{}
+	for _, v := range t {
+		s = append(s, map[string]interface{}{
+			"resource_type": aws.StringValue(v.ResourceType),
+			"tags":          tagsToMap(v.Tags, ignoreConfig),
+		})
+	}
+	return s
+}
@@ -209,7 +209,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(d.Get("tags_all").(map[string]interface{}), meta.(*AWSClient).ignoreTagsConfig),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -321,7 +321,7 @@ func resourceAwsLbUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
 	if !d.IsNewResource() {
-		if err := setElbV2Tags(elbconn, d); err != nil {
+		if err := setElbV2Tags(elbconn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return errwrap.Wrapf("Error Modifying Tags on ALB: {{err}}", err)
 		}
 	}
@@ -660,7 +660,7 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 		et = respTags.TagDescriptions[0].Tags
 	}
 
-	if err := d.Set("tags", tagsToMapELBv2(et)); err != nil {
+	if err := d.Set(tagsKey(d), tagsToMapELBv2(et, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
 	}
 
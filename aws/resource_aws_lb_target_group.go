@@ -276,7 +276,7 @@ func resourceAwsLbTargetGroupRead(d *schema.ResourceData, meta interface{}) erro
 func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbv2conn
 
-	if err := setElbV2Tags(elbconn, d); err != nil {
+	if err := setElbV2Tags(elbconn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return errwrap.Wrapf("Error Modifying Tags on LB Target Group: {{err}}", err)
 	}
 
@@ -579,7 +579,7 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	}
 	for _, t := range tagsResp.TagDescriptions {
 		if *t.ResourceArn == d.Id() {
-			if err := d.Set("tags", tagsToMapELBv2(t.Tags)); err != nil {
+			if err := d.Set(tagsKey(d), tagsToMapELBv2(t.Tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 				return err
 			}
 		}
@@ -129,7 +129,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	d.Set("tags_all", tagsToMap(ng.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -140,10 +140,10 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	// Turn on partial mode
 	d.Partial(true)
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsNatGatewayRead(d, meta)
@@ -198,7 +198,7 @@ func resourceAwsRoute53HealthCheckUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	if err := setTagsR53(conn, d, "healthcheck"); err != nil {
+	if err := setTagsR53(conn, d, "healthcheck", meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -304,7 +304,7 @@ func resourceAwsRoute53HealthCheckCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(*resp.HealthCheck.Id)
 
-	if err := setTagsR53(conn, d, "healthcheck"); err != nil {
+	if err := setTagsR53(conn, d, "healthcheck", meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -367,7 +367,7 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := d.Set("tags_all", tagsToMapR53(tags, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsS3Bucket() *schema.Resource {
@@ -29,6 +30,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 			State: resourceAwsS3BucketImportState,
 		},
 
+		CustomizeDiff: resourceAwsS3BucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:          schema.TypeString,
@@ -372,6 +375,57 @@ func resourceAwsS3Bucket() *schema.Resource {
 													Optional:     true,
 													ValidateFunc: validateS3BucketReplicationDestinationStorageClass,
 												},
+												"replica_kms_key_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"account_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateAwsAccountId,
+												},
+												"access_control_translation": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"owner": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	s3.OwnerOverrideDestination,
+																}, false),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"source_selection_criteria": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 1,
+										Set:      sourceSelectionCriteriaHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms_encrypted_objects": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Set:      sourceSseKmsObjectsHash,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enabled": {
+																Type:     schema.TypeBool,
+																Required: true,
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -505,41 +559,41 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
-	if err := setTagsS3(s3conn, d); err != nil {
+	if err := setTagsS3(s3conn, d, meta.(*AWSClient).ignoreTagsConfig, meta.(*AWSClient).retryConfig); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
 	if d.HasChange("policy") {
-		if err := resourceAwsS3BucketPolicyUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketPolicyUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceAwsS3BucketCorsUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketCorsUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("website") {
-		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("acl") {
-		if err := resourceAwsS3BucketAclUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketAclUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
@@ -551,25 +605,25 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceAwsS3BucketAccelerationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketAccelerationUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceAwsS3BucketRequestPayerUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketRequestPayerUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("server_side_encryption_configuration") {
-		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d, meta.(*AWSClient).retryConfig); err != nil {
 			return err
 		}
 	}
@@ -582,7 +636,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	var err error
 
-	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err = retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.HeadBucket(&s3.HeadBucketInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -609,7 +663,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
 
-		pol, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		pol, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 				Bucket: aws.String(d.Id()),
 			})
@@ -635,7 +689,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the CORS
-	corsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	corsResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -671,7 +725,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the website configuration
-	wsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	wsResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -731,7 +785,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the versioning configuration
 
-	versioningResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	versioningResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -763,7 +817,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the acceleration status
 
-	accelerateResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	accelerateResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -792,7 +846,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the request payer configuration.
 
-	payerResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	payerResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -809,7 +863,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the logging configuration
-	loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	loggingResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -837,7 +891,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the lifecycle configuration
 
-	lifecycleResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	lifecycleResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -866,9 +920,10 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 					if filter.And.Prefix != nil && *filter.And.Prefix != "" {
 						rule["prefix"] = *filter.And.Prefix
 					}
-					// Tag
+					// Tag. These select the objects the rule applies to and
+					// are not bucket tags, so ignore_tags does not apply.
 					if len(filter.And.Tags) > 0 {
-						rule["tags"] = tagsToMapS3(filter.And.Tags)
+						rule["tags"] = tagsToMapS3(filter.And.Tags, nil)
 					}
 				} else {
 					// Prefix
@@ -964,7 +1019,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the bucket replication configuration
 
-	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	replicationResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -986,7 +1041,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the bucket server side encryption configuration
 
-	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	encryptionResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -1011,7 +1066,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Add the region as an attribute
 
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	locationResponse, err := retryOnAwsCode(meta.(*AWSClient).retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(d.Id()),
@@ -1038,7 +1093,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Add website_endpoint as an attribute
-	websiteEndpoint, err := websiteEndpoint(s3conn, d)
+	websiteEndpoint, err := websiteEndpoint(s3conn, d, meta.(*AWSClient).retryConfig)
 	if err != nil {
 		return err
 	}
@@ -1056,7 +1111,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
+	if err := d.Set("tags_all", tagsToMapS3(tagSet, meta.(*AWSClient).ignoreTagsConfig)); err != nil {
 		return err
 	}
 
@@ -1133,7 +1188,7 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
@@ -1162,7 +1217,7 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 		}
 	} else {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
 				Bucket: aws.String(bucket),
 			})
@@ -1176,7 +1231,7 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 	return nil
 }
 
-func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1184,7 +1239,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		// Delete CORS
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
 
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
 				Bucket: aws.String(bucket),
 			})
@@ -1230,7 +1285,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		}
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
 
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.PutBucketCors(corsInput)
 		})
 		if err != nil {
@@ -1241,7 +1296,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 1 {
@@ -1251,15 +1306,15 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 		} else {
 			w = make(map[string]interface{})
 		}
-		return resourceAwsS3BucketWebsitePut(s3conn, d, w)
+		return resourceAwsS3BucketWebsitePut(s3conn, d, w, retryConfig)
 	} else if len(ws) == 0 {
-		return resourceAwsS3BucketWebsiteDelete(s3conn, d)
+		return resourceAwsS3BucketWebsiteDelete(s3conn, d, retryConfig)
 	} else {
 		return fmt.Errorf("Cannot specify more than one website.")
 	}
 }
 
-func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1323,7 +1378,7 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 
 	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketWebsite(putInput)
 	})
 	if err != nil {
@@ -1333,13 +1388,13 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	log.Printf("[DEBUG] S3 delete bucket website: %#v", deleteInput)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.DeleteBucketWebsite(deleteInput)
 	})
 	if err != nil {
@@ -1352,7 +1407,7 @@ func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error) {
+func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) (*S3Website, error) {
 	// If the bucket doesn't have a website configuration, return an empty
 	// endpoint
 	if _, ok := d.GetOk("website"); !ok {
@@ -1363,7 +1418,7 @@ func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error)
 
 	// Lookup the region for this bucket
 
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	locationResponse, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(bucket),
@@ -1422,7 +1477,7 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1432,7 +1487,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	}
 	log.Printf("[DEBUG] S3 put bucket ACL: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketAcl(i)
 	})
 	if err != nil {
@@ -1442,7 +1497,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -1472,7 +1527,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	}
 	log.Printf("[DEBUG] S3 put bucket versioning: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketVersioning(i)
 	})
 	if err != nil {
@@ -1482,7 +1537,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1507,7 +1562,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	}
 	log.Printf("[DEBUG] S3 put bucket logging: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketLogging(i)
 	})
 	if err != nil {
@@ -1517,7 +1572,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
@@ -1529,7 +1584,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 	}
 	log.Printf("[DEBUG] S3 put bucket acceleration: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketAccelerateConfiguration(i)
 	})
 	if err != nil {
@@ -1539,7 +1594,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	payer := d.Get("request_payer").(string)
 
@@ -1551,7 +1606,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	}
 	log.Printf("[DEBUG] S3 put bucket request payer: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketRequestPayment(i)
 	})
 	if err != nil {
@@ -1561,7 +1616,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	serverSideEncryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
 	if len(serverSideEncryptionConfiguration) == 0 {
@@ -1613,7 +1668,7 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 	}
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketEncryption(i)
 	})
 	if err != nil {
@@ -1623,7 +1678,7 @@ func resourceAwsS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d
 	return nil
 }
 
-func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData, retryConfig *awsRetryConfig) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
@@ -1668,29 +1723,7 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	rcRules := c["rules"].(*schema.Set).List()
 	rules := []*s3.ReplicationRule{}
 	for _, v := range rcRules {
-		rr := v.(map[string]interface{})
-		rcRule := &s3.ReplicationRule{
-			Prefix: aws.String(rr["prefix"].(string)),
-			Status: aws.String(rr["status"].(string)),
-		}
-
-		if rrid, ok := rr["id"]; ok {
-			rcRule.ID = aws.String(rrid.(string))
-		}
-
-		ruleDestination := &s3.Destination{}
-		if destination, ok := rr["destination"]; ok {
-			dest := destination.(*schema.Set).List()
-
-			bd := dest[0].(map[string]interface{})
-			ruleDestination.Bucket = aws.String(bd["bucket"].(string))
-
-			if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
-				ruleDestination.StorageClass = aws.String(storageClass.(string))
-			}
-		}
-		rcRule.Destination = ruleDestination
-		rules = append(rules, rcRule)
+		rules = append(rules, expandS3BucketReplicationRule(v.(map[string]interface{})))
 	}
 
 	rc.Rules = rules
@@ -1700,7 +1733,7 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	}
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryConfig, "s3", "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketReplication(i)
 	})
 	if err != nil {
@@ -1710,6 +1743,123 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	return nil
 }
 
+func expandS3BucketReplicationRule(rr map[string]interface{}) *s3.ReplicationRule {
+	rcRule := &s3.ReplicationRule{
+		Prefix: aws.String(rr["prefix"].(string)),
+		Status: aws.String(rr["status"].(string)),
+	}
+
+	if rrid, ok := rr["id"]; ok {
+		rcRule.ID = aws.String(rrid.(string))
+	}
+
+	ruleDestination := &s3.Destination{}
+	if destination, ok := rr["destination"].(*schema.Set); ok && destination.Len() > 0 {
+		bd := destination.List()[0].(map[string]interface{})
+		ruleDestination.Bucket = aws.String(bd["bucket"].(string))
+
+		if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
+			ruleDestination.StorageClass = aws.String(storageClass.(string))
+		}
+
+		if replicaKmsKeyId, ok := bd["replica_kms_key_id"]; ok && replicaKmsKeyId != "" {
+			ruleDestination.EncryptionConfiguration = &s3.EncryptionConfiguration{
+				ReplicaKmsKeyID: aws.String(replicaKmsKeyId.(string)),
+			}
+		}
+
+		if account, ok := bd["account_id"]; ok && account != "" {
+			ruleDestination.Account = aws.String(account.(string))
+		}
+
+		if aclTranslation, ok := bd["access_control_translation"].([]interface{}); ok && len(aclTranslation) > 0 {
+			aclTranslationValues := aclTranslation[0].(map[string]interface{})
+			ruleDestination.AccessControlTranslation = &s3.AccessControlTranslation{
+				Owner: aws.String(aclTranslationValues["owner"].(string)),
+			}
+		}
+	}
+	rcRule.Destination = ruleDestination
+
+	if ssc, ok := rr["source_selection_criteria"].(*schema.Set); ok && ssc.Len() > 0 {
+		sscValues := ssc.List()[0].(map[string]interface{})
+		ruleSsc := &s3.SourceSelectionCriteria{}
+		if sseKms, ok := sscValues["sse_kms_encrypted_objects"].(*schema.Set); ok && sseKms.Len() > 0 {
+			sseKmsValues := sseKms.List()[0].(map[string]interface{})
+			sseKmsEncryptedObjects := &s3.SseKmsEncryptedObjects{}
+			if sseKmsValues["enabled"].(bool) {
+				sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusEnabled)
+			} else {
+				sseKmsEncryptedObjects.Status = aws.String(s3.SseKmsEncryptedObjectsStatusDisabled)
+			}
+			ruleSsc.SseKmsEncryptedObjects = sseKmsEncryptedObjects
+		}
+		rcRule.SourceSelectionCriteria = ruleSsc
+	}
+
+	return rcRule
+}
+
+func resourceAwsS3BucketCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	replicationConfiguration := diff.Get("replication_configuration").([]interface{})
+	if len(replicationConfiguration) == 0 || replicationConfiguration[0] == nil {
+		return nil
+	}
+
+	c := replicationConfiguration[0].(map[string]interface{})
+	for _, v := range c["rules"].(*schema.Set).List() {
+		if err := validateS3BucketReplicationRule(expandS3BucketReplicationRule(v.(map[string]interface{}))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateS3BucketReplicationRule checks the settings S3 only accepts in
+// combination, so that an invalid rule is reported at plan time.
+func validateS3BucketReplicationRule(rule *s3.ReplicationRule) error {
+	ruleID := aws.StringValue(rule.ID)
+
+	sseKmsEnabled := false
+	if ssc := rule.SourceSelectionCriteria; ssc != nil && ssc.SseKmsEncryptedObjects != nil {
+		sseKmsEnabled = aws.StringValue(ssc.SseKmsEncryptedObjects.Status) == s3.SseKmsEncryptedObjectsStatusEnabled
+	}
+
+	replicaKmsKeyId := ""
+	if ec := rule.Destination.EncryptionConfiguration; ec != nil {
+		replicaKmsKeyId = aws.StringValue(ec.ReplicaKmsKeyID)
+	}
+
+	if sseKmsEnabled && replicaKmsKeyId == "" {
+		return fmt.Errorf("replication rule %q: replica_kms_key_id must be set in destination when sse_kms_encrypted_objects is enabled", ruleID)
+	}
+	if !sseKmsEnabled && replicaKmsKeyId != "" {
+		return fmt.Errorf("replication rule %q: sse_kms_encrypted_objects must be enabled in source_selection_criteria when replica_kms_key_id is set", ruleID)
+	}
+	if rule.Destination.AccessControlTranslation != nil && aws.StringValue(rule.Destination.Account) == "" {
+		return fmt.Errorf("replication rule %q: account_id must be set in destination when access_control_translation is set", ruleID)
+	}
+
+	return nil
+}
+
+// expandS3LifecycleRuleFilter returns the filter for a lifecycle_rule. Its
+// tags select the objects the rule applies to, so ignore_tags does not apply.
+func expandS3LifecycleRuleFilter(r map[string]interface{}) *s3.LifecycleRuleFilter {
+	tags := r["tags"].(map[string]interface{})
+	filter := &s3.LifecycleRuleFilter{}
+	if len(tags) > 0 {
+		lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
+		lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
+		lifecycleRuleAndOp.SetTags(tagsFromMapS3(tags, nil))
+		filter.SetAnd(lifecycleRuleAndOp)
+	} else {
+		filter.SetPrefix(r["prefix"].(string))
+	}
+	return filter
+}
+
 func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("bucket").(string)
 
@@ -1740,17 +1890,7 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 		rule := &s3.LifecycleRule{}
 
 		// Filter
-		tags := r["tags"].(map[string]interface{})
-		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) > 0 {
-			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
-			lifecycleRuleAndOp.SetTags(tagsFromMapS3(tags))
-			filter.SetAnd(lifecycleRuleAndOp)
-		} else {
-			filter.SetPrefix(r["prefix"].(string))
-		}
-		rule.SetFilter(filter)
+		rule.SetFilter(expandS3LifecycleRuleFilter(r))
 
 		// ID
 		if val, ok := r["id"].(string); ok && val != "" {
@@ -1907,6 +2047,20 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 			if v.Destination.StorageClass != nil {
 				rd["storage_class"] = *v.Destination.StorageClass
 			}
+			if v.Destination.EncryptionConfiguration != nil {
+				if v.Destination.EncryptionConfiguration.ReplicaKmsKeyID != nil {
+					rd["replica_kms_key_id"] = *v.Destination.EncryptionConfiguration.ReplicaKmsKeyID
+				}
+			}
+			if v.Destination.Account != nil {
+				rd["account_id"] = *v.Destination.Account
+			}
+			if v.Destination.AccessControlTranslation != nil {
+				rdt := map[string]interface{}{
+					"owner": aws.StringValue(v.Destination.AccessControlTranslation.Owner),
+				}
+				rd["access_control_translation"] = []interface{}{rdt}
+			}
 			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
 		}
 
@@ -1919,6 +2073,19 @@ func flattenAwsS3BucketReplicationConfiguration(r *s3.ReplicationConfiguration)
 		if v.Status != nil {
 			t["status"] = *v.Status
 		}
+		if vssc := v.SourceSelectionCriteria; vssc != nil {
+			tssc := make(map[string]interface{})
+			if vssc.SseKmsEncryptedObjects != nil {
+				tSseKms := make(map[string]interface{})
+				if aws.StringValue(vssc.SseKmsEncryptedObjects.Status) == s3.SseKmsEncryptedObjectsStatusEnabled {
+					tSseKms["enabled"] = true
+				} else {
+					tSseKms["enabled"] = false
+				}
+				tssc["sse_kms_encrypted_objects"] = schema.NewSet(sourceSseKmsObjectsHash, []interface{}{tSseKms})
+			}
+			t["source_selection_criteria"] = schema.NewSet(sourceSelectionCriteriaHash, []interface{}{tssc})
+		}
 		rules = append(rules, t)
 	}
 	m["rules"] = schema.NewSet(rulesHash, rules)
@@ -2107,6 +2274,53 @@ func destinationHash(v interface{}) int {
 	if v, ok := m["storage_class"]; ok {
 		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
 	}
+	// The fields below are only hashed when set, so that existing
+	// destinations keep their hash.
+	if v, ok := m["replica_kms_key_id"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["account_id"]; ok && v.(string) != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	if v, ok := m["access_control_translation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		buf.WriteString(fmt.Sprintf("%d-", accessControlTranslationHash(v[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func accessControlTranslationHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["owner"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func sourceSelectionCriteriaHash(v interface{}) int {
+	var buf bytes.Buffer
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	if v, ok := m["sse_kms_encrypted_objects"].(*schema.Set); ok && v.Len() > 0 {
+		buf.WriteString(fmt.Sprintf("%d-", sourceSseKmsObjectsHash(v.List()[0])))
+	}
+	return hashcode.String(buf.String())
+}
+
+func sourceSseKmsObjectsHash(v interface{}) int {
+	var buf bytes.Buffer
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	if v, ok := m["enabled"]; ok {
+		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
+	}
 	return hashcode.String(buf.String())
 }
 
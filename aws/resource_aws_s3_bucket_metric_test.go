@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3MetricsFilter(t *testing.T) {
+	testCases := []struct {
+		Config   map[string]interface{}
+		Expected *s3.MetricsFilter
+	}{
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			Expected: &s3.MetricsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.MetricsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3MetricsFilter(tc.Config)
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestFlattenS3MetricsFilter(t *testing.T) {
+	testCases := []struct {
+		Filter   *s3.MetricsFilter
+		Expected map[string]interface{}
+	}{
+		{
+			Filter: &s3.MetricsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+			Expected: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+		},
+		{
+			Filter: &s3.MetricsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+			Expected: map[string]interface{}{
+				"tags": map[string]string{
+					"tag1key": "tag1value",
+				},
+			},
+		},
+		{
+			Filter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+						{
+							Key:   aws.String("tag2key"),
+							Value: aws.String("tag2value"),
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]string{
+					"tag1key": "tag1value",
+					"tag2key": "tag2value",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := flattenS3MetricsFilter(tc.Filter)
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Fatalf("Case #%d: Given:\n%#v\n\nExpected:\n%#v", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestS3MetricsFilter_ignoreTags(t *testing.T) {
+	ignoreConfig := newIgnoreTagsConfig(nil, []string{"team:"})
+	if !tagIgnoredS3(&s3.Tag{Key: aws.String("team:name")}, ignoreConfig) {
+		t.Fatal("expected team:name to be ignored as a resource tag")
+	}
+
+	config := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"team:name": "storage",
+		},
+	}
+	filter := expandS3MetricsFilter(config)
+	if filter.Tag == nil || aws.StringValue(filter.Tag.Key) != "team:name" {
+		t.Fatalf("expected team:name to be kept in the filter, got %s", filter)
+	}
+
+	expected := map[string]interface{}{
+		"tags": map[string]string{
+			"team:name": "storage",
+		},
+	}
+	if value := flattenS3MetricsFilter(filter); !reflect.DeepEqual(value, expected) {
+		t.Fatalf("Given:\n%#v\n\nExpected:\n%#v", value, expected)
+	}
+}
+
+func TestAccAWSS3BucketMetric_basic(t *testing.T) {
+	var conf s3.MetricsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_metric.test"
+
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	metricName := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketMetricConfig(bucketName, metricName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSS3BucketMetricExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", metricName),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketMetricConfig_filterPrefixAndTags(bucketName, metricName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSS3BucketMetricExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketMetricDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_metric" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketMetricParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("S3 bucket metrics configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketMetricExists(n string, res *s3.MetricsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket metrics configuration ID is set")
+		}
+
+		bucket, name, err := resourceAwsS3BucketMetricParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		output, err := conn.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *output.MetricsConfiguration
+		return nil
+	}
+}
+
+func testAccAWSS3BucketMetricConfig(bucketName, metricName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+}
+`, bucketName, metricName)
+}
+
+func testAccAWSS3BucketMetricConfig_filterPrefixAndTags(bucketName, metricName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "aws_s3_bucket_metric" "test" {
+  bucket = "${aws_s3_bucket.bucket.id}"
+  name   = "%s"
+
+  filter {
+    prefix = "prefix/"
+
+    tags {
+      "tag1" = "value1"
+      "tag2" = "value2"
+    }
+  }
+}
+`, bucketName, metricName)
+}
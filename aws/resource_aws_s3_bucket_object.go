@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -25,6 +26,9 @@ func resourceAwsS3BucketObject() *schema.Resource {
 		Read:   resourceAwsS3BucketObjectRead,
 		Update: resourceAwsS3BucketObjectPut,
 		Delete: resourceAwsS3BucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3BucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -198,7 +202,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		if restricted {
 			return fmt.Errorf("This region does not allow for tags on S3 objects")
 		}
@@ -296,7 +300,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return fmt.Errorf("Failed to get object tags (bucket: %s, key: %s): %s", bucket, key, err)
 		}
-		d.Set("tags", tagsToMapS3(tagResp.TagSet))
+		d.Set("tags_all", tagsToMapS3(tagResp.TagSet, meta.(*AWSClient).ignoreTagsConfig))
 	}
 
 	return nil
@@ -346,6 +350,93 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceAwsS3BucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected BUCKET/KEY", d.Id())
+	}
+
+	bucket := parts[0]
+	key := parts[1]
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.SetId(key)
+
+	// The canned ACL an object was created with is not returned by S3, so
+	// try to infer it from the grants. Anything we cannot map is left at
+	// the schema default.
+	s3conn := meta.(*AWSClient).s3conn
+	resp, err := s3conn.GetObjectAcl(&s3.GetObjectAclInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading S3 object ACL (bucket: %s, key: %s): %s", bucket, key, err)
+	}
+
+	if acl, ok := s3ObjectCannedAclFromGrants(resp.Owner, resp.Grants); ok {
+		d.Set("acl", acl)
+	} else {
+		log.Printf("[WARN] Unable to determine canned ACL of S3 object (bucket: %s, key: %s), defaulting to %q", bucket, key, s3.ObjectCannedACLPrivate)
+		d.Set("acl", s3.ObjectCannedACLPrivate)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// s3ObjectCannedAclFromGrants returns the canned ACL matching the given
+// object owner and grants, if there is one.
+func s3ObjectCannedAclFromGrants(owner *s3.Owner, grants []*s3.Grant) (string, bool) {
+	const (
+		allUsersUri           = "http://acs.amazonaws.com/groups/global/AllUsers"
+		authenticatedUsersUri = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	)
+
+	ownerFullControl := false
+	groupPerms := make(map[string][]string)
+	for _, g := range grants {
+		if g.Grantee == nil {
+			return "", false
+		}
+		permission := aws.StringValue(g.Permission)
+
+		switch aws.StringValue(g.Grantee.Type) {
+		case s3.TypeCanonicalUser:
+			if owner == nil || aws.StringValue(g.Grantee.ID) != aws.StringValue(owner.ID) || permission != s3.PermissionFullControl {
+				return "", false
+			}
+			ownerFullControl = true
+		case s3.TypeGroup:
+			uri := aws.StringValue(g.Grantee.URI)
+			groupPerms[uri] = append(groupPerms[uri], permission)
+		default:
+			return "", false
+		}
+	}
+
+	if !ownerFullControl {
+		return "", false
+	}
+
+	for _, perms := range groupPerms {
+		sort.Strings(perms)
+	}
+
+	switch {
+	case len(groupPerms) == 0:
+		return s3.ObjectCannedACLPrivate, true
+	case len(groupPerms) == 1 && reflect.DeepEqual(groupPerms[allUsersUri], []string{s3.PermissionRead}):
+		return s3.ObjectCannedACLPublicRead, true
+	case len(groupPerms) == 1 && reflect.DeepEqual(groupPerms[allUsersUri], []string{s3.PermissionRead, s3.PermissionWrite}):
+		return s3.ObjectCannedACLPublicReadWrite, true
+	case len(groupPerms) == 1 && reflect.DeepEqual(groupPerms[authenticatedUsersUri], []string{s3.PermissionRead}):
+		return s3.ObjectCannedACLAuthenticatedRead, true
+	}
+
+	return "", false
+}
+
 func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -404,6 +404,85 @@ func TestResourceAWSS3BucketObjectAcl_validation(t *testing.T) {
 	}
 }
 
+func TestS3ObjectCannedAclFromGrants(t *testing.T) {
+	owner := &s3.Owner{
+		ID: aws.String("owner"),
+	}
+	ownerGrant := &s3.Grant{
+		Grantee: &s3.Grantee{
+			ID:   aws.String("owner"),
+			Type: aws.String(s3.TypeCanonicalUser),
+		},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+	groupGrant := func(uri, permission string) *s3.Grant {
+		return &s3.Grant{
+			Grantee: &s3.Grantee{
+				Type: aws.String(s3.TypeGroup),
+				URI:  aws.String(uri),
+			},
+			Permission: aws.String(permission),
+		}
+	}
+	allUsers := "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsers := "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	testCases := []struct {
+		Grants   []*s3.Grant
+		Expected string
+		Ok       bool
+	}{
+		{
+			Grants:   []*s3.Grant{ownerGrant},
+			Expected: s3.ObjectCannedACLPrivate,
+			Ok:       true,
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(allUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLPublicRead,
+			Ok:       true,
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(allUsers, s3.PermissionWrite), groupGrant(allUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLPublicReadWrite,
+			Ok:       true,
+		},
+		{
+			Grants:   []*s3.Grant{ownerGrant, groupGrant(authenticatedUsers, s3.PermissionRead)},
+			Expected: s3.ObjectCannedACLAuthenticatedRead,
+			Ok:       true,
+		},
+		{
+			Grants: []*s3.Grant{ownerGrant, groupGrant(allUsers, s3.PermissionRead), groupGrant(authenticatedUsers, s3.PermissionRead)},
+		},
+		{
+			Grants: []*s3.Grant{
+				ownerGrant,
+				{
+					Grantee: &s3.Grantee{
+						ID:   aws.String("other"),
+						Type: aws.String(s3.TypeCanonicalUser),
+					},
+					Permission: aws.String(s3.PermissionRead),
+				},
+			},
+		},
+		{
+			Grants: []*s3.Grant{groupGrant(allUsers, s3.PermissionRead)},
+		},
+	}
+
+	for i, tc := range testCases {
+		acl, ok := s3ObjectCannedAclFromGrants(owner, tc.Grants)
+		if ok != tc.Ok {
+			t.Fatalf("Case #%d: expected ok to be %t, got %t", i, tc.Ok, ok)
+		}
+		if acl != tc.Expected {
+			t.Fatalf("Case #%d: expected %q, got %q", i, tc.Expected, acl)
+		}
+	}
+}
+
 func TestAccAWSS3BucketObject_storageClass(t *testing.T) {
 	rInt := acctest.RandInt()
 	var obj s3.GetObjectOutput
@@ -18,6 +18,9 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 		Read:   resourceAwsS3BucketPolicyRead,
 		Update: resourceAwsS3BucketPolicyPut,
 		Delete: resourceAwsS3BucketPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -85,6 +88,7 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("policy", v); err != nil {
 		return err
 	}
+	d.Set("bucket", d.Id())
 
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -810,6 +811,221 @@ func TestAccAWSS3Bucket_ReplicationWithoutStorageClass(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3Bucket_ReplicationWithSseKmsEncryptedObjects(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+	providerFactories := map[string]terraform.ResourceProviderFactory{
+		"aws": func() (terraform.ResourceProvider, error) {
+			p := Provider()
+			providers = append(providers, p.(*schema.Provider))
+			return p, nil
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAWSS3BucketDestroyWithProviders(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketConfigReplicationWithSseKmsEncryptedObjects(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExistsWithProviders("aws_s3_bucket.bucket", &providers),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.2229345141.id", "foobar"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "replication_configuration.0.rules.2229345141.source_selection_criteria.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3Bucket_ReplicationExpectSseKmsValidationError(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	// record the initialized providers so that we can use them to check for the instances in each region
+	var providers []*schema.Provider
+	providerFactories := map[string]terraform.ResourceProviderFactory{
+		"aws": func() (terraform.ResourceProvider, error) {
+			p := Provider()
+			providers = append(providers, p.(*schema.Provider))
+			return p, nil
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAWSS3BucketDestroyWithProviders(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSS3BucketConfigReplicationWithSseKmsMissingReplicaKey(rInt),
+				ExpectError: regexp.MustCompile(`replica_kms_key_id must be set in destination when sse_kms_encrypted_objects is enabled`),
+			},
+		},
+	})
+}
+
+func TestResourceAwsS3BucketCustomizeDiff_replication(t *testing.T) {
+	rule := func(destination map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"bucket": "tf-test-bucket",
+			"replication_configuration": []interface{}{
+				map[string]interface{}{
+					"role": "arn:aws:iam::123456789012:role/replication",
+					"rules": []interface{}{
+						map[string]interface{}{
+							"id":          "foobar",
+							"prefix":      "foo",
+							"status":      "Enabled",
+							"destination": []interface{}{destination},
+							"source_selection_criteria": []interface{}{
+								map[string]interface{}{
+									"sse_kms_encrypted_objects": []interface{}{
+										map[string]interface{}{
+											"enabled": true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Config: rule(map[string]interface{}{
+				"bucket":             "arn:aws:s3:::destination",
+				"replica_kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/example",
+			}),
+		},
+		{
+			// Values that are not known until apply must not fail the plan.
+			Config: rule(map[string]interface{}{
+				"bucket":             config.UnknownVariableValue,
+				"replica_kms_key_id": config.UnknownVariableValue,
+			}),
+		},
+		{
+			Config: rule(map[string]interface{}{
+				"bucket": "arn:aws:s3:::destination",
+			}),
+			ExpectError: true,
+		},
+	}
+
+	for i, tc := range cases {
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		_, err = resourceAwsS3Bucket().Diff(nil, terraform.NewResourceConfig(rc), &AWSClient{})
+		if tc.ExpectError && err == nil {
+			t.Fatalf("%d: expected error", i)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+	}
+}
+
+func TestValidateS3BucketReplicationRule(t *testing.T) {
+	sseKms := func(status string) *s3.SourceSelectionCriteria {
+		return &s3.SourceSelectionCriteria{
+			SseKmsEncryptedObjects: &s3.SseKmsEncryptedObjects{
+				Status: aws.String(status),
+			},
+		}
+	}
+
+	testCases := []struct {
+		Rule        *s3.ReplicationRule
+		ExpectError bool
+	}{
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					Bucket: aws.String("arn:aws:s3:::destination"),
+				},
+			},
+		},
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					Bucket: aws.String("arn:aws:s3:::destination"),
+					EncryptionConfiguration: &s3.EncryptionConfiguration{
+						ReplicaKmsKeyID: aws.String("arn:aws:kms:us-west-2:123456789012:key/example"),
+					},
+				},
+				SourceSelectionCriteria: sseKms(s3.SseKmsEncryptedObjectsStatusEnabled),
+			},
+		},
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					Bucket: aws.String("arn:aws:s3:::destination"),
+				},
+				SourceSelectionCriteria: sseKms(s3.SseKmsEncryptedObjectsStatusEnabled),
+			},
+			ExpectError: true,
+		},
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					Bucket: aws.String("arn:aws:s3:::destination"),
+					EncryptionConfiguration: &s3.EncryptionConfiguration{
+						ReplicaKmsKeyID: aws.String("arn:aws:kms:us-west-2:123456789012:key/example"),
+					},
+				},
+				SourceSelectionCriteria: sseKms(s3.SseKmsEncryptedObjectsStatusDisabled),
+			},
+			ExpectError: true,
+		},
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					Account: aws.String("123456789012"),
+					AccessControlTranslation: &s3.AccessControlTranslation{
+						Owner: aws.String(s3.OwnerOverrideDestination),
+					},
+					Bucket: aws.String("arn:aws:s3:::destination"),
+				},
+			},
+		},
+		{
+			Rule: &s3.ReplicationRule{
+				Destination: &s3.Destination{
+					AccessControlTranslation: &s3.AccessControlTranslation{
+						Owner: aws.String(s3.OwnerOverrideDestination),
+					},
+					Bucket: aws.String("arn:aws:s3:::destination"),
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := validateS3BucketReplicationRule(tc.Rule)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Case #%d: expected error", i)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Case #%d: unexpected error: %s", i, err)
+		}
+	}
+}
+
 func TestAccAWSS3Bucket_ReplicationExpectVersioningValidationError(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -836,6 +1052,35 @@ func TestAccAWSS3Bucket_ReplicationExpectVersioningValidationError(t *testing.T)
 	})
 }
 
+func TestExpandS3LifecycleRuleFilter_ignoreTags(t *testing.T) {
+	ignoreConfig := newIgnoreTagsConfig([]string{"Environment"}, nil)
+	if !tagIgnoredS3(&s3.Tag{Key: aws.String("Environment")}, ignoreConfig) {
+		t.Fatal("expected Environment to be ignored as a resource tag")
+	}
+
+	filter := expandS3LifecycleRuleFilter(map[string]interface{}{
+		"prefix": "logs/",
+		"tags": map[string]interface{}{
+			"Environment": "production",
+		},
+	})
+
+	expected := &s3.LifecycleRuleFilter{
+		And: &s3.LifecycleRuleAndOperator{
+			Prefix: aws.String("logs/"),
+			Tags: []*s3.Tag{
+				{
+					Key:   aws.String("Environment"),
+					Value: aws.String("production"),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(filter, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, filter)
+	}
+}
+
 func TestAWSS3BucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -898,6 +1143,159 @@ func TestAWSS3BucketName(t *testing.T) {
 	}
 }
 
+func TestAWSS3Bucket_offline(t *testing.T) {
+	bucket := "/tf-offline-bucket"
+	s3Response := func(body string) *awsMockResponse {
+		return &awsMockResponse{200, body, "application/xml"}
+	}
+	versioning := func(status string) *awsMockResponse {
+		return s3Response(fmt.Sprintf(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">%s</VersioningConfiguration>`, status))
+	}
+
+	server := newAwsOfflineServer(t,
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "PUT " + bucket,
+			Response:  s3Response(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "HEAD " + bucket,
+			Response:  s3Response(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "PUT " + bucket + "?acl",
+			Response:  s3Response(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "PUT " + bucket + "?tagging",
+			Response:  s3Response(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "PUT " + bucket + "?versioning",
+			Response:  s3Response(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?tagging",
+			Response: s3Response(`<Tagging xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <TagSet>
+    <Tag><Key>Usage</Key><Value>offline</Value></Tag>
+  </TagSet>
+</Tagging>`),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?cors",
+			Response:  awsOfflineRestXmlError(404, "NoSuchCORSConfiguration", "The CORS configuration does not exist"),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?website",
+			Response:  awsOfflineRestXmlError(404, "NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration"),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?versioning",
+			Response:  versioning(""),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?accelerate",
+			Response:  s3Response(`<AccelerateConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?requestPayment",
+			Response: s3Response(`<RequestPaymentConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Payer>BucketOwner</Payer>
+</RequestPaymentConfiguration>`),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?logging",
+			Response:  s3Response(`<BucketLoggingStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?lifecycle",
+			Response:  awsOfflineRestXmlError(404, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist"),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?replication",
+			Response:  awsOfflineRestXmlError(404, "ReplicationConfigurationNotFoundError", "The replication configuration was not found"),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?encryption",
+			Response: awsOfflineRestXmlError(404, "ServerSideEncryptionConfigurationNotFoundError",
+				"The server side encryption configuration was not found"),
+		},
+		&awsOfflineOperation{
+			Service:   "s3",
+			Operation: "GET " + bucket + "?location",
+			Response:  s3Response(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/"/>`),
+		},
+	)
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckAWSS3BucketDestroyWithProvider(s, testAccProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccAWSS3BucketConfigOffline(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketExists("aws_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "versioning.0.enabled", "false"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "tags.Usage", "offline"),
+				),
+			},
+			{
+				PreConfig: func() {
+					server.Add(
+						&awsOfflineOperation{
+							Service:   "s3",
+							Operation: "GET " + bucket + "?versioning",
+							After:     "PUT " + bucket + "?versioning",
+							Response:  versioning("<Status>Enabled</Status>"),
+						},
+						&awsOfflineOperation{
+							Service:   "s3",
+							Operation: "DELETE " + bucket,
+							Response:  &awsMockResponse{204, "", "application/xml"},
+						},
+						&awsOfflineOperation{
+							Service:   "s3",
+							Operation: "DELETE " + bucket,
+							After:     "DELETE " + bucket,
+							Response:  awsOfflineRestXmlError(404, "NoSuchBucket", "The specified bucket does not exist"),
+						},
+					)
+				},
+				Config: server.providerConfig() + testAccAWSS3BucketConfigOffline(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket.bucket", "versioning.0.enabled", "true"),
+					func(*terraform.State) error {
+						if n := server.Calls("s3", "PUT "+bucket); n != 1 {
+							return fmt.Errorf("Expected the bucket to be created once, got %d CreateBucket calls", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSS3BucketDestroy(s *terraform.State) error {
 	return testAccCheckInstanceDestroyWithProvider(s, testAccProvider)
 }
@@ -1832,6 +2230,100 @@ resource "aws_s3_bucket" "destination" {
 `, randInt, randInt, randInt)
 }
 
+func testAccAWSS3BucketConfigReplicationWithSseKmsEncryptedObjects(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_kms_key" "replica" {
+  provider                = "aws.euwest"
+  description             = "TF Acceptance Test S3 repl KMS key"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foobar"
+            prefix = "foo"
+            status = "Enabled"
+
+            destination {
+                bucket             = "${aws_s3_bucket.destination.arn}"
+                storage_class      = "STANDARD"
+                replica_kms_key_id = "${aws_kms_key.replica.arn}"
+            }
+
+            source_selection_criteria {
+                sse_kms_encrypted_objects {
+                    enabled = true
+                }
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
+func testAccAWSS3BucketConfigReplicationWithSseKmsMissingReplicaKey(randInt int) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+    provider = "aws.uswest2"
+    bucket   = "tf-test-bucket-%d"
+    acl      = "private"
+
+    versioning {
+        enabled = true
+    }
+
+    replication_configuration {
+        role = "${aws_iam_role.role.arn}"
+        rules {
+            id     = "foobar"
+            prefix = "foo"
+            status = "Enabled"
+
+            destination {
+                bucket = "${aws_s3_bucket.destination.arn}"
+            }
+
+            source_selection_criteria {
+                sse_kms_encrypted_objects {
+                    enabled = true
+                }
+            }
+        }
+    }
+}
+
+resource "aws_s3_bucket" "destination" {
+    provider = "aws.euwest"
+    bucket   = "tf-test-bucket-destination-%d"
+    region   = "eu-west-1"
+
+    versioning {
+        enabled = true
+    }
+}
+`, randInt, randInt, randInt)
+}
+
 func testAccAWSS3BucketConfigReplicationWithoutStorageClass(randInt int) string {
 	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
 resource "aws_s3_bucket" "bucket" {
@@ -1914,3 +2406,19 @@ resource "aws_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
 }
 `
+
+func testAccAWSS3BucketConfigOffline(versioning bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = "tf-offline-bucket"
+
+  versioning {
+    enabled = %t
+  }
+
+  tags {
+    Usage = "offline"
+  }
+}
+`, versioning)
+}
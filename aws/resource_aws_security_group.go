@@ -284,7 +284,7 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 			d.Id(), err)
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -387,7 +387,7 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[WARN] Error setting Egress rule set for (%s): %s", d.Id(), err)
 	}
 
-	d.Set("tags", tagsToMap(sg.Tags))
+	d.Set("tags_all", tagsToMap(sg.Tags, meta.(*AWSClient).ignoreTagsConfig))
 	return nil
 }
 
@@ -418,10 +418,10 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if !d.IsNewResource() {
-		if err := setTags(conn, d); err != nil {
+		if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 			return err
 		}
-		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsSecurityGroupRead(d, meta)
@@ -0,0 +1,315 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceDiscoveryService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceDiscoveryServiceCreate,
+		Read:   resourceAwsServiceDiscoveryServiceRead,
+		Update: resourceAwsServiceDiscoveryServiceUpdate,
+		Delete: resourceAwsServiceDiscoveryServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"dns_records": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											servicediscovery.RecordTypeSrv,
+											servicediscovery.RecordTypeA,
+											servicediscovery.RecordTypeAaaa,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"health_check_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicediscovery.HealthCheckTypeHttp,
+								servicediscovery.HealthCheckTypeHttps,
+								servicediscovery.HealthCheckTypeTcp,
+							}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceDiscoveryServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.CreateServiceInput{
+		Name:      aws.String(d.Get("name").(string)),
+		DnsConfig: expandServiceDiscoveryDnsConfig(d.Get("dns_config").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_config"); ok {
+		input.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Discovery Service: %s", input)
+	resp, err := conn.CreateService(input)
+	if err != nil {
+		return fmt.Errorf("Error creating Service Discovery Service: %s", err)
+	}
+
+	d.SetId(*resp.Service.Id)
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.GetServiceInput{
+		Id: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetService(input)
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			log.Printf("[WARN] Service Discovery Service (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	service := resp.Service
+	d.Set("arn", service.Arn)
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	if err := d.Set("dns_config", flattenServiceDiscoveryDnsConfig(service.DnsConfig)); err != nil {
+		return fmt.Errorf("Error setting dns_config: %s", err)
+	}
+	if err := d.Set("health_check_config", flattenServiceDiscoveryHealthCheckConfig(service.HealthCheckConfig)); err != nil {
+		return fmt.Errorf("Error setting health_check_config: %s", err)
+	}
+	return nil
+}
+
+func resourceAwsServiceDiscoveryServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	// UpdateService replaces the DNS records and health check configuration,
+	// so both have to be sent even if only one of them changed.
+	dnsConfig := d.Get("dns_config").([]interface{})[0].(map[string]interface{})
+	input := &servicediscovery.UpdateServiceInput{
+		Id: aws.String(d.Id()),
+		Service: &servicediscovery.ServiceChange{
+			DnsConfig: &servicediscovery.DnsConfigChange{
+				DnsRecords: expandServiceDiscoveryDnsRecords(dnsConfig["dns_records"].([]interface{})),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Service.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_config"); ok {
+		input.Service.HealthCheckConfig = expandServiceDiscoveryHealthCheckConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Service Discovery Service: %s", input)
+	resp, err := conn.UpdateService(input)
+	if err != nil {
+		return fmt.Errorf("Error updating Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicediscovery.OperationStatusSubmitted, servicediscovery.OperationStatusPending},
+		Target:  []string{servicediscovery.OperationStatusSuccess},
+		Refresh: servicediscoveryOperationRefreshStatusFunc(conn, *resp.OperationId),
+		Timeout: 5 * time.Minute,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsServiceDiscoveryServiceRead(d, meta)
+}
+
+func resourceAwsServiceDiscoveryServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sdconn
+
+	input := &servicediscovery.DeleteServiceInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Discovery Service: %s", d.Id())
+	_, err := conn.DeleteService(input)
+	if err != nil {
+		if isAWSErr(err, servicediscovery.ErrCodeServiceNotFound, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Service Discovery Service (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandServiceDiscoveryDnsConfig(configured map[string]interface{}) *servicediscovery.DnsConfig {
+	return &servicediscovery.DnsConfig{
+		NamespaceId: aws.String(configured["namespace_id"].(string)),
+		DnsRecords:  expandServiceDiscoveryDnsRecords(configured["dns_records"].([]interface{})),
+	}
+}
+
+func flattenServiceDiscoveryDnsConfig(config *servicediscovery.DnsConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"namespace_id": aws.StringValue(config.NamespaceId),
+		"dns_records":  flattenServiceDiscoveryDnsRecords(config.DnsRecords),
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandServiceDiscoveryDnsRecords(configured []interface{}) []*servicediscovery.DnsRecord {
+	records := make([]*servicediscovery.DnsRecord, 0, len(configured))
+
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		records = append(records, &servicediscovery.DnsRecord{
+			TTL:  aws.Int64(int64(data["ttl"].(int))),
+			Type: aws.String(data["type"].(string)),
+		})
+	}
+
+	return records
+}
+
+func flattenServiceDiscoveryDnsRecords(records []*servicediscovery.DnsRecord) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(records))
+
+	for _, record := range records {
+		result = append(result, map[string]interface{}{
+			"ttl":  aws.Int64Value(record.TTL),
+			"type": aws.StringValue(record.Type),
+		})
+	}
+
+	return result
+}
+
+func expandServiceDiscoveryHealthCheckConfig(configured []interface{}) *servicediscovery.HealthCheckConfig {
+	if len(configured) < 1 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	config := &servicediscovery.HealthCheckConfig{}
+
+	if v, ok := data["failure_threshold"]; ok && v.(int) != 0 {
+		config.FailureThreshold = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := data["resource_path"]; ok && v.(string) != "" {
+		config.ResourcePath = aws.String(v.(string))
+	}
+	if v, ok := data["type"]; ok && v.(string) != "" {
+		config.Type = aws.String(v.(string))
+	}
+
+	return config
+}
+
+func flattenServiceDiscoveryHealthCheckConfig(config *servicediscovery.HealthCheckConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+
+	if config.FailureThreshold != nil {
+		result["failure_threshold"] = aws.Int64Value(config.FailureThreshold)
+	}
+	if config.ResourcePath != nil {
+		result["resource_path"] = aws.StringValue(config.ResourcePath)
+	}
+	if config.Type != nil {
+		result["type"] = aws.StringValue(config.Type)
+	}
+
+	return []map[string]interface{}{result}
+}
@@ -75,7 +75,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := []*servicecatalog.Tag{}
 		t := v.(map[string]interface{})
 		for k, v := range t {
@@ -127,7 +127,7 @@ func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interfa
 	for _, tag := range resp.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	d.Set("tags", tags)
+	d.Set("tags_all", tags)
 	return nil
 }
 
@@ -158,8 +158,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		currentTags, requiredTags := d.GetChange("tags_all")
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
@@ -197,6 +197,88 @@ func testAccCheckAWSNSTopicHasDeliveryPolicy(n string, expectedPolicyText string
 	}
 }
 
+func TestAWSSNSTopic_offline(t *testing.T) {
+	topicArn := "arn:aws:sns:us-east-1:123456789012:tf-offline-topic"
+	topicAttributes := func(displayName string) string {
+		return fmt.Sprintf(`    <Attributes>
+      <entry><key>TopicArn</key><value>%s</value></entry>
+      <entry><key>DisplayName</key><value>%s</value></entry>
+      <entry><key>SubscriptionsConfirmed</key><value>0</value></entry>
+    </Attributes>`, topicArn, displayName)
+	}
+
+	server := newAwsOfflineServer(t,
+		&awsOfflineOperation{
+			Service:   "sns",
+			Operation: "CreateTopic",
+			Params:    map[string]string{"Name": "tf-offline-topic"},
+			Response:  awsOfflineQueryResponse("CreateTopic", "    <TopicArn>"+topicArn+"</TopicArn>"),
+		},
+		&awsOfflineOperation{
+			Service:   "sns",
+			Operation: "SetTopicAttributes",
+			Params:    map[string]string{"TopicArn": topicArn, "AttributeName": "DisplayName"},
+			Response:  awsOfflineQueryResponse("SetTopicAttributes", ""),
+		},
+		&awsOfflineOperation{
+			Service:   "sns",
+			Operation: "GetTopicAttributes",
+			Params:    map[string]string{"TopicArn": topicArn},
+			Response:  awsOfflineQueryResponse("GetTopicAttributes", topicAttributes("original")),
+		},
+	)
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSNSTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccAWSSNSTopicConfigOffline("original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSNSTopicExists("aws_sns_topic.test_topic"),
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "arn", topicArn),
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "display_name", "original"),
+				),
+			},
+			{
+				PreConfig: func() {
+					server.Add(
+						&awsOfflineOperation{
+							Service:   "sns",
+							Operation: "GetTopicAttributes",
+							After:     "SetTopicAttributes",
+							Response:  awsOfflineQueryResponse("GetTopicAttributes", topicAttributes("changed")),
+						},
+						&awsOfflineOperation{
+							Service:   "sns",
+							Operation: "DeleteTopic",
+							Params:    map[string]string{"TopicArn": topicArn},
+							Response:  awsOfflineQueryResponse("DeleteTopic", ""),
+						},
+						&awsOfflineOperation{
+							Service:   "sns",
+							Operation: "GetTopicAttributes",
+							After:     "DeleteTopic",
+							Response:  awsOfflineQueryError(404, "NotFound", "Topic does not exist"),
+						},
+					)
+				},
+				Config: server.providerConfig() + testAccAWSSNSTopicConfigOffline("changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sns_topic.test_topic", "display_name", "changed"),
+					func(*terraform.State) error {
+						if n := server.Calls("sns", "CreateTopic"); n != 1 {
+							return fmt.Errorf("Expected the topic to be created once, got %d CreateTopic calls", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSNSTopicDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).snsconn
 
@@ -379,3 +461,12 @@ EOF
 }
 `, r)
 }
+
+func testAccAWSSNSTopicConfigOffline(displayName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test_topic" {
+  name         = "tf-offline-topic"
+  display_name = "%s"
+}
+`, displayName)
+}
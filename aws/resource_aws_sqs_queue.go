@@ -199,7 +199,7 @@ func resourceAwsSqsQueueCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsSqsQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 	sqsconn := meta.(*AWSClient).sqsconn
 
-	if err := setTagsSQS(sqsconn, d); err != nil {
+	if err := setTagsSQS(sqsconn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -304,7 +304,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapGeneric(listTagsOutput.Tags))
+	d.Set("tags_all", tagsToMapGeneric(listTagsOutput.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -337,10 +337,10 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 
 }
 
-func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
+func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
+		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}), ignoreConfig)
 
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
@@ -219,6 +219,114 @@ func TestAccAWSSQSQueue_ExpectContentBasedDeduplicationError(t *testing.T) {
 	})
 }
 
+func TestAWSSQSQueue_offline(t *testing.T) {
+	queueUrl := "https://queue.amazonaws.com/123456789012/tf-offline-queue"
+	queueAttributes := func(delaySeconds string) string {
+		return fmt.Sprintf(`    <Attribute><Name>QueueArn</Name><Value>arn:aws:sqs:us-east-1:123456789012:tf-offline-queue</Value></Attribute>
+    <Attribute><Name>DelaySeconds</Name><Value>%s</Value></Attribute>
+    <Attribute><Name>MaximumMessageSize</Name><Value>262144</Value></Attribute>
+    <Attribute><Name>MessageRetentionPeriod</Name><Value>345600</Value></Attribute>
+    <Attribute><Name>ReceiveMessageWaitTimeSeconds</Name><Value>0</Value></Attribute>
+    <Attribute><Name>VisibilityTimeout</Name><Value>30</Value></Attribute>`, delaySeconds)
+	}
+
+	server := newAwsOfflineServer(t,
+		&awsOfflineOperation{
+			Service:   "sqs",
+			Operation: "CreateQueue",
+			Params:    map[string]string{"QueueName": "tf-offline-queue"},
+			Response:  awsOfflineQueryResponse("CreateQueue", "    <QueueUrl>"+queueUrl+"</QueueUrl>"),
+		},
+		&awsOfflineOperation{
+			Service:   "sqs",
+			Operation: "SetQueueAttributes",
+			Response:  awsOfflineQueryResponse("SetQueueAttributes", ""),
+		},
+		&awsOfflineOperation{
+			Service:   "sqs",
+			Operation: "TagQueue",
+			Response:  awsOfflineQueryResponse("TagQueue", ""),
+		},
+		&awsOfflineOperation{
+			Service:   "sqs",
+			Operation: "GetQueueAttributes",
+			Response:  awsOfflineQueryResponse("GetQueueAttributes", queueAttributes("90")),
+		},
+		&awsOfflineOperation{
+			Service:   "sqs",
+			Operation: "ListQueueTags",
+			Response: awsOfflineQueryResponse("ListQueueTags",
+				"    <Tag><Key>Usage</Key><Value>original</Value></Tag>"),
+		},
+	)
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerConfig() + testAccAWSSQSConfigOffline(90, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "arn", "arn:aws:sqs:us-east-1:123456789012:tf-offline-queue"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "delay_seconds", "90"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.%", "1"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Usage", "original"),
+				),
+			},
+			{
+				PreConfig: func() {
+					server.Add(
+						&awsOfflineOperation{
+							Service:   "sqs",
+							Operation: "GetQueueAttributes",
+							After:     "SetQueueAttributes",
+							Response:  awsOfflineQueryResponse("GetQueueAttributes", queueAttributes("60")),
+						},
+						&awsOfflineOperation{
+							Service:   "sqs",
+							Operation: "ListQueueTags",
+							After:     "TagQueue",
+							Response: awsOfflineQueryResponse("ListQueueTags",
+								"    <Tag><Key>Usage</Key><Value>changed</Value></Tag>"),
+						},
+						&awsOfflineOperation{
+							Service:   "sqs",
+							Operation: "UntagQueue",
+							Params:    map[string]string{"TagKey.1": "Usage"},
+							Response:  awsOfflineQueryResponse("UntagQueue", ""),
+						},
+						&awsOfflineOperation{
+							Service:   "sqs",
+							Operation: "DeleteQueue",
+							Params:    map[string]string{"QueueUrl": queueUrl},
+							Response:  awsOfflineQueryResponse("DeleteQueue", ""),
+						},
+						&awsOfflineOperation{
+							Service:   "sqs",
+							Operation: "GetQueueAttributes",
+							After:     "DeleteQueue",
+							Response: awsOfflineQueryError(400, "AWS.SimpleQueueService.NonExistentQueue",
+								"The specified queue does not exist for this wsdl version."),
+						},
+					)
+				},
+				Config: server.providerConfig() + testAccAWSSQSConfigOffline(60, "changed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "delay_seconds", "60"),
+					resource.TestCheckResourceAttr("aws_sqs_queue.queue", "tags.Usage", "changed"),
+					func(*terraform.State) error {
+						if n := server.Calls("sqs", "CreateQueue"); n != 1 {
+							return fmt.Errorf("Expected the queue to be created once, got %d CreateQueue calls", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).sqsconn
 
@@ -620,3 +728,16 @@ resource "aws_sqs_queue" "queue" {
 }
 `, r)
 }
+
+func testAccAWSSQSConfigOffline(delaySeconds int, usage string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name          = "tf-offline-queue"
+  delay_seconds = %d
+
+  tags {
+    Usage = "%s"
+  }
+}
+`, delaySeconds, usage)
+}
@@ -154,7 +154,7 @@ func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("ipv6_cidr_block", "")
 		}
 	}
-	d.Set("tags", tagsToMap(subnet.Tags))
+	d.Set("tags_all", tagsToMap(subnet.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -164,10 +164,10 @@ func resourceAwsSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
-		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("map_public_ip_on_launch") {
@@ -33,6 +33,27 @@ func resourceAwsVpc() *schema.Resource {
 				ValidateFunc: validateCIDRNetworkAddress,
 			},
 
+			"cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"instance_tenancy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -179,8 +200,12 @@ func resourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 
+	if err := d.Set("cidr_block_associations", flattenVpcCidrBlockAssociations(vpc.CidrBlockAssociationSet)); err != nil {
+		return fmt.Errorf("error setting cidr_block_associations: %s", err)
+	}
+
 	// Tags
-	d.Set("tags", tagsToMap(vpc.Tags))
+	d.Set("tags_all", tagsToMap(vpc.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
 		if *a.Ipv6CidrBlockState.State == "associated" { //we can only ever have 1 IPv6 block associated at once
@@ -452,10 +477,10 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("assign_generated_ipv6_cidr_block")
 	}
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	} else {
-		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -520,6 +545,23 @@ func VPCStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	}
 }
 
+// flattenVpcCidrBlockAssociations returns every IPv4 CIDR block associated
+// with a VPC, including the primary block specified at creation time.
+func flattenVpcCidrBlockAssociations(associations []*ec2.VpcCidrBlockAssociation) []interface{} {
+	result := make([]interface{}, 0, len(associations))
+	for _, association := range associations {
+		m := map[string]interface{}{
+			"association_id": aws.StringValue(association.AssociationId),
+			"cidr_block":     aws.StringValue(association.CidrBlock),
+		}
+		if association.CidrBlockState != nil {
+			m["state"] = aws.StringValue(association.CidrBlockState.State)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
 func Ipv6CidrStateRefreshFunc(conn *ec2.EC2, id string, associationId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		describeVpcOpts := &ec2.DescribeVpcsInput{
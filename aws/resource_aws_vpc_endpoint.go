@@ -3,12 +3,26 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// VPC endpoint states are returned in camel case, unlike the ec2.State enum values.
+	vpcEndpointStatePending           = "pending"
+	vpcEndpointStatePendingAcceptance = "pendingAcceptance"
+	vpcEndpointStateAvailable         = "available"
+	vpcEndpointStateDeleting          = "deleting"
+	vpcEndpointStateDeleted           = "deleted"
+	vpcEndpointStateRejected          = "rejected"
+	vpcEndpointStateFailed            = "failed"
 )
 
 func resourceAwsVpcEndpoint() *schema.Resource {
@@ -22,6 +36,16 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.VpcEndpointTypeGateway,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeInterface,
+				}, false),
+			},
 			"policy": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -49,6 +73,29 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"prefix_list_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +105,34 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"network_interface_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_entry": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -65,8 +140,10 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.CreateVpcEndpointInput{
-		VpcId:       aws.String(d.Get("vpc_id").(string)),
-		ServiceName: aws.String(d.Get("service_name").(string)),
+		VpcId:             aws.String(d.Get("vpc_id").(string)),
+		VpcEndpointType:   aws.String(d.Get("vpc_endpoint_type").(string)),
+		ServiceName:       aws.String(d.Get("service_name").(string)),
+		PrivateDnsEnabled: aws.Bool(d.Get("private_dns_enabled").(bool)),
 	}
 
 	if v, ok := d.GetOk("route_table_ids"); ok {
@@ -76,6 +153,20 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("subnet_ids"); ok {
+		list := v.(*schema.Set).List()
+		if len(list) > 0 {
+			input.SubnetIds = expandStringList(list)
+		}
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		list := v.(*schema.Set).List()
+		if len(list) > 0 {
+			input.SecurityGroupIds = expandStringList(list)
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		policy, err := normalizeJsonString(v)
 		if err != nil {
@@ -93,39 +184,66 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*output.VpcEndpoint.VpcEndpointId)
 
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
 func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	input := &ec2.DescribeVpcEndpointsInput{
-		VpcEndpointIds: []*string{aws.String(d.Id())},
-	}
 
 	log.Printf("[DEBUG] Reading VPC Endpoint: %q", d.Id())
-	output, err := conn.DescribeVpcEndpoints(input)
-
+	vpceRaw, state, err := vpcEndpointStateRefresh(conn, d.Id())()
 	if err != nil {
-		ec2err, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
-		}
+		return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
+	}
 
-		if ec2err.Code() == "InvalidVpcEndpointId.NotFound" {
-			log.Printf("[WARN] VPC Endpoint (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
+	if state == vpcEndpointStateDeleted {
+		log.Printf("[WARN] VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
 
-		return fmt.Errorf("Error reading VPC Endpoint: %s", err.Error())
+	vpce := vpceRaw.(*ec2.VpcEndpoint)
+
+	d.Set("vpc_id", vpce.VpcId)
+	d.Set("vpc_endpoint_type", vpce.VpcEndpointType)
+	d.Set("service_name", vpce.ServiceName)
+	d.Set("state", vpce.State)
+	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
+
+	if vpce.PolicyDocument != nil {
+		policy, err := normalizeJsonString(*vpce.PolicyDocument)
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+		d.Set("policy", policy)
 	}
 
-	if len(output.VpcEndpoints) != 1 {
-		return fmt.Errorf("There's no unique VPC Endpoint, but %d endpoints: %#v",
-			len(output.VpcEndpoints), output.VpcEndpoints)
+	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_ids", aws.StringValueSlice(vpce.SubnetIds)); err != nil {
+		return err
+	}
+	if err := d.Set("security_group_ids", flattenVpcEndpointSecurityGroupIds(vpce.Groups)); err != nil {
+		return err
+	}
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(vpce.NetworkInterfaceIds)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_entry", flattenVpcEndpointDnsEntries(vpce.DnsEntries)); err != nil {
+		return err
 	}
 
-	vpce := output.VpcEndpoints[0]
+	// Only Gateway type endpoints are associated with a prefix list.
+	if aws.StringValue(vpce.VpcEndpointType) != ec2.VpcEndpointTypeGateway {
+		d.Set("prefix_list_id", "")
+		d.Set("cidr_blocks", []string{})
+		return nil
+	}
 
 	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
 	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
@@ -150,17 +268,6 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
 	}
 
-	policy, err := normalizeJsonString(*vpce.PolicyDocument)
-	if err != nil {
-		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
-	}
-
-	d.Set("vpc_id", vpce.VpcId)
-	d.Set("policy", policy)
-	d.Set("service_name", vpce.ServiceName)
-	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
-		return err
-	}
 	pl := prefixListsOutput.PrefixLists[0]
 	d.Set("prefix_list_id", pl.PrefixListId)
 	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
@@ -190,6 +297,42 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		add := expandStringList(ns.Difference(os).List())
+		if len(add) > 0 {
+			input.AddSubnetIds = add
+		}
+
+		remove := expandStringList(os.Difference(ns).List())
+		if len(remove) > 0 {
+			input.RemoveSubnetIds = remove
+		}
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		add := expandStringList(ns.Difference(os).List())
+		if len(add) > 0 {
+			input.AddSecurityGroupIds = add
+		}
+
+		remove := expandStringList(os.Difference(ns).List())
+		if len(remove) > 0 {
+			input.RemoveSecurityGroupIds = remove
+		}
+	}
+
+	if d.HasChange("private_dns_enabled") {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
+	}
+
 	if d.HasChange("policy") {
 		policy, err := normalizeJsonString(d.Get("policy"))
 		if err != nil {
@@ -205,6 +348,10 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 	log.Printf("[DEBUG] VPC Endpoint %q updated", input.VpcEndpointId)
 
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
@@ -230,8 +377,100 @@ func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			vpcEndpointStateAvailable,
+			vpcEndpointStatePending,
+			vpcEndpointStatePendingAcceptance,
+			vpcEndpointStateDeleting,
+			vpcEndpointStateRejected,
+			vpcEndpointStateFailed,
+		},
+		Target:     []string{vpcEndpointStateDeleted},
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %q to delete: %s", d.Id(), err.Error())
+	}
+
 	log.Printf("[DEBUG] VPC Endpoint %q deleted", d.Id())
 	d.SetId("")
 
 	return nil
 }
+
+// vpcEndpointStateRefresh returns a resource.StateRefreshFunc that is used to watch
+// a VPC Endpoint. A VPC Endpoint that can no longer be found is reported as deleted.
+func vpcEndpointStateRefresh(conn *ec2.EC2, vpceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: []*string{aws.String(vpceId)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+				return "", vpcEndpointStateDeleted, nil
+			}
+
+			return nil, "", err
+		}
+
+		if resp == nil || len(resp.VpcEndpoints) == 0 || resp.VpcEndpoints[0] == nil {
+			return "", vpcEndpointStateDeleted, nil
+		}
+
+		vpce := resp.VpcEndpoints[0]
+		return vpce, aws.StringValue(vpce.State), nil
+	}
+}
+
+// vpcEndpointWaitUntilAvailable waits for a VPC Endpoint to become available.
+// An endpoint for a service that requires acceptance stays in the pendingAcceptance
+// state until the service owner accepts the connection, so that state is also a target.
+func vpcEndpointWaitUntilAvailable(conn *ec2.EC2, vpceId string, timeout time.Duration) error {
+	refresh := func() (interface{}, string, error) {
+		vpce, state, err := vpcEndpointStateRefresh(conn, vpceId)()
+		// Treat a rejected or failed endpoint as an error so that the wait stops early.
+		if err == nil && (state == vpcEndpointStateRejected || state == vpcEndpointStateFailed) {
+			return vpce, state, fmt.Errorf("VPC Endpoint %q is in state %q", vpceId, state)
+		}
+		return vpce, state, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{vpcEndpointStatePending},
+		Target:     []string{vpcEndpointStateAvailable, vpcEndpointStatePendingAcceptance},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %q to become available: %s", vpceId, err.Error())
+	}
+
+	return nil
+}
+
+func flattenVpcEndpointSecurityGroupIds(groups []*ec2.SecurityGroupIdentifier) []string {
+	groupIds := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIds = append(groupIds, aws.StringValue(group.GroupId))
+	}
+
+	return groupIds
+}
+
+func flattenVpcEndpointDnsEntries(dnsEntries []*ec2.DnsEntry) []interface{} {
+	entries := make([]interface{}, 0, len(dnsEntries))
+	for _, dnsEntry := range dnsEntries {
+		entries = append(entries, map[string]interface{}{
+			"dns_name":       aws.StringValue(dnsEntry.DnsName),
+			"hosted_zone_id": aws.StringValue(dnsEntry.HostedZoneId),
+		})
+	}
+
+	return entries
+}
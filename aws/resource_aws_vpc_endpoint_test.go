@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -82,6 +83,70 @@ func TestAccAWSVpcEndpoint_WithoutRouteTableOrPolicyConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_interfaceWithSubnetAndSecurityGroup(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint.ec2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: resourceName,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnet,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_entry.0.dns_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_entry.0.hosted_zone_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceWithSubnetModified,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "private_dns_enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSVpcEndpoint_interfaceNonAWSService(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+	resourceName := "aws_vpc_endpoint.foo"
+	lbName := fmt.Sprintf("tf-acc-nlb-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: resourceName,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointConfig_interfaceNonAWSService(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr(resourceName, "state", "pendingAcceptance"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -305,3 +370,182 @@ resource "aws_vpc_endpoint" "second-private-s3" {
     service_name = "com.amazonaws.us-west-2.s3"
 }
 `
+
+const testAccVpcEndpointConfig_interfaceWithSubnet = `
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-iface-w-subnet"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "sn1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.0.0/17"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.128.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+}
+
+resource "aws_subnet" "sn3" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.192.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name      = "com.amazonaws.us-west-2.ec2"
+
+  subnet_ids = [
+    "${aws_subnet.sn1.id}",
+  ]
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+  ]
+
+  private_dns_enabled = false
+}
+`
+
+const testAccVpcEndpointConfig_interfaceWithSubnetModified = `
+resource "aws_vpc" "foo" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-iface-w-subnet"
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_subnet" "sn1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.0.0/17"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+}
+
+resource "aws_subnet" "sn2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.128.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[1]}"
+}
+
+resource "aws_subnet" "sn3" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.192.0/18"
+  availability_zone = "${data.aws_availability_zones.available.names[2]}"
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name      = "com.amazonaws.us-west-2.ec2"
+
+  subnet_ids = [
+    "${aws_subnet.sn1.id}",
+    "${aws_subnet.sn2.id}",
+    "${aws_subnet.sn3.id}",
+  ]
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+    "${aws_security_group.sg2.id}",
+  ]
+
+  private_dns_enabled = true
+}
+`
+
+func testAccVpcEndpointConfig_interfaceNonAWSService(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-iface-non-aws-svc"
+  }
+}
+
+resource "aws_lb" "nlb_test" {
+  name = "%s"
+
+  subnets = [
+    "${aws_subnet.nlb_test_1.id}",
+    "${aws_subnet.nlb_test_2.id}",
+  ]
+
+  load_balancer_type         = "network"
+  internal                   = true
+  idle_timeout               = 60
+  enable_deletion_protection = false
+}
+
+resource "aws_subnet" "nlb_test_1" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+}
+
+resource "aws_subnet" "nlb_test_2" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  cidr_block        = "10.0.2.0/24"
+  availability_zone = "us-west-2b"
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required = true
+
+  network_load_balancer_arns = [
+    "${aws_lb.nlb_test.id}",
+  ]
+}
+
+resource "aws_security_group" "sg1" {
+  vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "foo" {
+  vpc_id            = "${aws_vpc.foo.id}"
+  vpc_endpoint_type = "Interface"
+  service_name      = "${aws_vpc_endpoint_service.foo.service_name}"
+
+  subnet_ids = [
+    "${aws_subnet.nlb_test_1.id}",
+  ]
+
+  security_group_ids = [
+    "${aws_security_group.sg1.id}",
+  ]
+}
+`, lbName)
+}
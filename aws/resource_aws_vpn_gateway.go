@@ -98,7 +98,7 @@ func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	if vpnGateway.AvailabilityZone != nil && *vpnGateway.AvailabilityZone != "" {
 		d.Set("availability_zone", vpnGateway.AvailabilityZone)
 	}
-	d.Set("tags", tagsToMap(vpnGateway.Tags))
+	d.Set("tags_all", tagsToMap(vpnGateway.Tags, meta.(*AWSClient).ignoreTagsConfig))
 
 	return nil
 }
@@ -118,11 +118,11 @@ func resourceAwsVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 
 	conn := meta.(*AWSClient).ec2conn
 
-	if err := setTags(conn, d); err != nil {
+	if err := setTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
-	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsVpnGatewayRead(d, meta)
 }
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafGeoMatchSet_basic(t *testing.T) {
+	var v waf.GeoMatchSet
+	resourceName := "aws_waf_geo_match_set.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafGeoMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafGeoMatchSetConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafGeoMatchSetConfig_changeConstraints(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafGeoMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "geo_match_constraint.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafGeoMatchSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_geo_match_set" {
+			continue
+		}
+
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "WAFNonexistentItemException", "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.GeoMatchSet.GeoMatchSetId) == rs.Primary.ID {
+			return fmt.Errorf("WAF GeoMatchSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafGeoMatchSetExists(n string, v *waf.GeoMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF GeoMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		resp, err := conn.GetGeoMatchSet(&waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.GeoMatchSet.GeoMatchSetId) != rs.Primary.ID {
+			return fmt.Errorf("WAF GeoMatchSet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.GeoMatchSet
+		return nil
+	}
+}
+
+func testAccAWSWafGeoMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "test" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "US"
+  }
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "CA"
+  }
+}
+`, name)
+}
+
+func testAccAWSWafGeoMatchSetConfig_changeConstraints(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_geo_match_set" "test" {
+  name = "%s"
+
+  geo_match_constraint {
+    type  = "Country"
+    value = "RU"
+  }
+}
+`, name)
+}
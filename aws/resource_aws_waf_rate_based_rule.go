@@ -55,9 +55,9 @@ func resourceAwsWafRateBasedRule() *schema.Resource {
 							Required: true,
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								value := v.(string)
-								if value != "IPMatch" && value != "ByteMatch" && value != "SqlInjectionMatch" && value != "SizeConstraint" && value != "XssMatch" {
+								if value != "IPMatch" && value != "ByteMatch" && value != "SqlInjectionMatch" && value != "GeoMatch" && value != "SizeConstraint" && value != "XssMatch" && value != "RegexMatch" {
 									errors = append(errors, fmt.Errorf(
-										"%q must be one of IPMatch | ByteMatch | SqlInjectionMatch | SizeConstraint | XssMatch", k))
+										"%q must be one of IPMatch | ByteMatch | SqlInjectionMatch | GeoMatch | SizeConstraint | XssMatch | RegexMatch", k))
 								}
 								return
 							},
@@ -125,18 +125,7 @@ func resourceAwsWafRateBasedRuleRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	var predicates []map[string]interface{}
-
-	for _, predicateSet := range resp.Rule.MatchPredicates {
-		predicate := map[string]interface{}{
-			"negated": *predicateSet.Negated,
-			"type":    *predicateSet.Type,
-			"data_id": *predicateSet.DataId,
-		}
-		predicates = append(predicates, predicate)
-	}
-
-	d.Set("predicates", predicates)
+	d.Set("predicates", flattenWafPredicates(resp.Rule.MatchPredicates))
 	d.Set("name", resp.Rule.Name)
 	d.Set("metric_name", resp.Rule.MetricName)
 	d.Set("rate_key", resp.Rule.RateKey)
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegexMatchSet_basic(t *testing.T) {
+	var v waf.RegexMatchSet
+	resourceName := "aws_waf_regex_match_set.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegexMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegexMatchSetConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegexMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "regex_match_tuple.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegexMatchSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_regex_match_set" {
+			continue
+		}
+
+		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
+			RegexMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "WAFNonexistentItemException", "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.RegexMatchSet.RegexMatchSetId) == rs.Primary.ID {
+			return fmt.Errorf("WAF RegexMatchSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegexMatchSetExists(n string, v *waf.RegexMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF RegexMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		resp, err := conn.GetRegexMatchSet(&waf.GetRegexMatchSetInput{
+			RegexMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.RegexMatchSet.RegexMatchSetId) != rs.Primary.ID {
+			return fmt.Errorf("WAF RegexMatchSet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.RegexMatchSet
+		return nil
+	}
+}
+
+func testAccAWSWafRegexMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["one", "two"]
+}
+
+resource "aws_waf_regex_match_set" "test" {
+  name = "%s"
+
+  regex_match_tuple {
+    field_to_match {
+      data = "User-Agent"
+      type = "HEADER"
+    }
+
+    regex_pattern_set_id = "${aws_waf_regex_pattern_set.test.id}"
+    text_transformation  = "NONE"
+  }
+}
+`, name, name)
+}
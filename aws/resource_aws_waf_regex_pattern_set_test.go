@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegexPatternSet_basic(t *testing.T) {
+	var v waf.RegexPatternSet
+	resourceName := "aws_waf_regex_pattern_set.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegexPatternSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegexPatternSetConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegexPatternSetConfig_changePatterns(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegexPatternSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "regex_pattern_strings.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegexPatternSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_regex_pattern_set" {
+			continue
+		}
+
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "WAFNonexistentItemException", "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.RegexPatternSet.RegexPatternSetId) == rs.Primary.ID {
+			return fmt.Errorf("WAF RegexPatternSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegexPatternSetExists(n string, v *waf.RegexPatternSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF RegexPatternSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		resp, err := conn.GetRegexPatternSet(&waf.GetRegexPatternSetInput{
+			RegexPatternSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.RegexPatternSet.RegexPatternSetId) != rs.Primary.ID {
+			return fmt.Errorf("WAF RegexPatternSet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.RegexPatternSet
+		return nil
+	}
+}
+
+func testAccAWSWafRegexPatternSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["one", "two"]
+}
+`, name)
+}
+
+func testAccAWSWafRegexPatternSetConfig_changePatterns(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_regex_pattern_set" "test" {
+  name                  = "%s"
+  regex_pattern_strings = ["two", "three", "four"]
+}
+`, name)
+}
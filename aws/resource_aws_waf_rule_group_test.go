@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRuleGroup_basic(t *testing.T) {
+	var v waf.RuleGroup
+	resourceName := "aws_waf_rule_group.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRuleGroupConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRuleGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", name),
+					resource.TestCheckResourceAttr(resourceName, "activated_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRuleGroupConfig_noActivatedRules(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRuleGroupExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "activated_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRuleGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_waf_rule_group" {
+			continue
+		}
+
+		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
+			RuleGroupId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, "WAFNonexistentItemException", "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.RuleGroup.RuleGroupId) == rs.Primary.ID {
+			return fmt.Errorf("WAF RuleGroup %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRuleGroupExists(n string, v *waf.RuleGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF RuleGroup ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafconn
+		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
+			RuleGroupId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.RuleGroup.RuleGroupId) != rs.Primary.ID {
+			return fmt.Errorf("WAF RuleGroup (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.RuleGroup
+		return nil
+	}
+}
+
+func testAccAWSWafRuleGroupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_rule" "test" {
+  name        = "%s"
+  metric_name = "%s"
+}
+
+resource "aws_waf_rule_group" "test" {
+  name        = "%s"
+  metric_name = "%s"
+
+  activated_rule {
+    action {
+      type = "COUNT"
+    }
+
+    priority = 50
+    rule_id  = "${aws_waf_rule.test.id}"
+  }
+}
+`, name, name, name, name)
+}
+
+func testAccAWSWafRuleGroupConfig_noActivatedRules(name string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_rule_group" "test" {
+  name        = "%s"
+  metric_name = "%s"
+}
+`, name, name)
+}
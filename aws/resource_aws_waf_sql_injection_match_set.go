@@ -98,7 +98,7 @@ func resourceAwsWafSqlInjectionMatchSetRead(d *schema.ResourceData, meta interfa
 	}
 
 	d.Set("name", resp.SqlInjectionMatchSet.Name)
-	d.Set("sql_injection_match_tuples", resp.SqlInjectionMatchSet.SqlInjectionMatchTuples)
+	d.Set("sql_injection_match_tuples", flattenWafSqlInjectionMatchTuples(resp.SqlInjectionMatchSet.SqlInjectionMatchTuples))
 
 	return nil
 }
@@ -166,49 +166,3 @@ func updateSqlInjectionMatchSetResource(id string, oldT, newT []interface{}, con
 
 	return nil
 }
-
-func flattenWafSqlInjectionMatchTuples(ts []*waf.SqlInjectionMatchTuple) []interface{} {
-	out := make([]interface{}, len(ts), len(ts))
-	for i, t := range ts {
-		m := make(map[string]interface{})
-		m["text_transformation"] = *t.TextTransformation
-		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		out[i] = m
-	}
-
-	return out
-}
-
-func diffWafSqlInjectionMatchTuples(oldT, newT []interface{}) []*waf.SqlInjectionMatchSetUpdate {
-	updates := make([]*waf.SqlInjectionMatchSetUpdate, 0)
-
-	for _, od := range oldT {
-		tuple := od.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nd := range newT {
-		tuple := nd.(map[string]interface{})
-
-		updates = append(updates, &waf.SqlInjectionMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			SqlInjectionMatchTuple: &waf.SqlInjectionMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
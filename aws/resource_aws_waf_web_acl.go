@@ -17,6 +17,8 @@ func resourceAwsWafWebAcl() *schema.Resource {
 		Update: resourceAwsWafWebAclUpdate,
 		Delete: resourceAwsWafWebAclDelete,
 
+		CustomizeDiff: resourceAwsWafWebAclCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -49,7 +51,20 @@ func resourceAwsWafWebAcl() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"action": &schema.Schema{
 							Type:     schema.TypeSet,
-							Required: true,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"override_action": &schema.Schema{
+							Type:     schema.TypeSet,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -70,9 +85,9 @@ func resourceAwsWafWebAcl() *schema.Resource {
 							Default:  waf.WafRuleTypeRegular,
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								value := v.(string)
-								if value != waf.WafRuleTypeRegular && value != waf.WafRuleTypeRateBased {
+								if value != waf.WafRuleTypeRegular && value != waf.WafRuleTypeRateBased && value != waf.WafRuleTypeGroup {
 									errors = append(errors, fmt.Errorf(
-										"%q must be one of %s | %s", k, waf.WafRuleTypeRegular, waf.WafRuleTypeRateBased))
+										"%q must be one of %s | %s | %s", k, waf.WafRuleTypeRegular, waf.WafRuleTypeRateBased, waf.WafRuleTypeGroup))
 								}
 								return
 							},
@@ -170,6 +185,42 @@ func resourceAwsWafWebAclDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceAwsWafWebAclCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	for _, rule := range diff.Get("rules").(*schema.Set).List() {
+		if err := validateWafWebAclRule(rule.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateWafWebAclRule checks that a rule sets the action matching its type:
+// REGULAR and RATE_BASED rules need an action, while GROUP rules can only
+// override the actions of the rules in the group.
+func validateWafWebAclRule(rule map[string]interface{}) error {
+	ruleType := rule["type"].(string)
+	hasAction := rule["action"].(*schema.Set).Len() > 0
+	hasOverrideAction := rule["override_action"].(*schema.Set).Len() > 0
+
+	switch ruleType {
+	case waf.WafRuleTypeRegular, waf.WafRuleTypeRateBased:
+		if !hasAction {
+			return fmt.Errorf("rule %q: action is required for rules of type %s", rule["rule_id"], ruleType)
+		}
+		if hasOverrideAction {
+			return fmt.Errorf("rule %q: override_action is only supported for rules of type %s", rule["rule_id"], waf.WafRuleTypeGroup)
+		}
+	case waf.WafRuleTypeGroup:
+		if !hasOverrideAction {
+			return fmt.Errorf("rule %q: override_action is required for rules of type %s", rule["rule_id"], ruleType)
+		}
+		if hasAction {
+			return fmt.Errorf("rule %q: action is not supported for rules of type %s, use override_action", rule["rule_id"], ruleType)
+		}
+	}
+	return nil
+}
+
 func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction string) error {
 	conn := meta.(*AWSClient).wafconn
 
@@ -187,15 +238,25 @@ func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction
 		rules := d.Get("rules").(*schema.Set)
 		for _, rule := range rules.List() {
 			aclRule := rule.(map[string]interface{})
-			action := aclRule["action"].(*schema.Set).List()[0].(map[string]interface{})
+			activatedRule := &waf.ActivatedRule{
+				Priority: aws.Int64(int64(aclRule["priority"].(int))),
+				RuleId:   aws.String(aclRule["rule_id"].(string)),
+				Type:     aws.String(aclRule["type"].(string)),
+			}
+
+			// Rule groups carry their own actions, a web ACL can only override them
+			if actions := aclRule["action"].(*schema.Set).List(); len(actions) > 0 {
+				action := actions[0].(map[string]interface{})
+				activatedRule.Action = &waf.WafAction{Type: aws.String(action["type"].(string))}
+			}
+			if overrideActions := aclRule["override_action"].(*schema.Set).List(); len(overrideActions) > 0 {
+				overrideAction := overrideActions[0].(map[string]interface{})
+				activatedRule.OverrideAction = &waf.WafOverrideAction{Type: aws.String(overrideAction["type"].(string))}
+			}
+
 			aclRuleUpdate := &waf.WebACLUpdate{
-				Action: aws.String(ChangeAction),
-				ActivatedRule: &waf.ActivatedRule{
-					Priority: aws.Int64(int64(aclRule["priority"].(int))),
-					RuleId:   aws.String(aclRule["rule_id"].(string)),
-					Type:     aws.String(aclRule["type"].(string)),
-					Action:   &waf.WafAction{Type: aws.String(action["type"].(string))},
-				},
+				Action:        aws.String(ChangeAction),
+				ActivatedRule: activatedRule,
 			}
 			req.Updates = append(req.Updates, aclRuleUpdate)
 		}
@@ -206,37 +267,3 @@ func updateWebAclResource(d *schema.ResourceData, meta interface{}, ChangeAction
 	}
 	return nil
 }
-
-func expandDefaultAction(d *schema.ResourceData) *waf.WafAction {
-	set, ok := d.GetOk("default_action")
-	if !ok {
-		return nil
-	}
-
-	s := set.(*schema.Set).List()
-	if s == nil || len(s) == 0 {
-		return nil
-	}
-
-	if s[0] == nil {
-		log.Printf("[ERR] First element of Default Action is set to nil")
-		return nil
-	}
-
-	dA := s[0].(map[string]interface{})
-
-	return &waf.WafAction{
-		Type: aws.String(dA["type"].(string)),
-	}
-}
-
-func flattenDefaultAction(n *waf.WafAction) []map[string]interface{} {
-	if n == nil {
-		return nil
-	}
-
-	m := setMap(make(map[string]interface{}))
-
-	m.SetString("type", n.Type)
-	return m.MapList()
-}
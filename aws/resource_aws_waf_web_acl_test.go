@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +15,96 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 )
 
+func TestValidateWafWebAclRule(t *testing.T) {
+	actionHash := func(v interface{}) int {
+		return hashcode.String(v.(map[string]interface{})["type"].(string))
+	}
+	action := func(actionType string) *schema.Set {
+		return schema.NewSet(actionHash, []interface{}{
+			map[string]interface{}{"type": actionType},
+		})
+	}
+	empty := func() *schema.Set {
+		return schema.NewSet(actionHash, []interface{}{})
+	}
+
+	cases := []struct {
+		Rule        map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "regular",
+				"type":            waf.WafRuleTypeRegular,
+				"action":          action(waf.WafActionTypeBlock),
+				"override_action": empty(),
+			},
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "rate-based",
+				"type":            waf.WafRuleTypeRateBased,
+				"action":          action(waf.WafActionTypeAllow),
+				"override_action": empty(),
+			},
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "group",
+				"type":            waf.WafRuleTypeGroup,
+				"action":          empty(),
+				"override_action": action(waf.WafOverrideActionTypeNone),
+			},
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "regular-without-action",
+				"type":            waf.WafRuleTypeRegular,
+				"action":          empty(),
+				"override_action": empty(),
+			},
+			ExpectError: true,
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "regular-with-override-action",
+				"type":            waf.WafRuleTypeRegular,
+				"action":          action(waf.WafActionTypeBlock),
+				"override_action": action(waf.WafOverrideActionTypeCount),
+			},
+			ExpectError: true,
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "group-without-override-action",
+				"type":            waf.WafRuleTypeGroup,
+				"action":          empty(),
+				"override_action": empty(),
+			},
+			ExpectError: true,
+		},
+		{
+			Rule: map[string]interface{}{
+				"rule_id":         "group-with-action",
+				"type":            waf.WafRuleTypeGroup,
+				"action":          action(waf.WafActionTypeBlock),
+				"override_action": action(waf.WafOverrideActionTypeNone),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateWafWebAclRule(tc.Rule)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected %q to trigger a validation error", tc.Rule["rule_id"])
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected %q not to trigger a validation error, got: %s", tc.Rule["rule_id"], err)
+		}
+	}
+}
+
 func TestAccAWSWafWebAcl_basic(t *testing.T) {
 	var v waf.WebACL
 	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
@@ -134,6 +226,29 @@ func TestAccAWSWafWebAcl_changeDefaultAction(t *testing.T) {
 	})
 }
 
+func TestAccAWSWafWebAcl_ruleGroup(t *testing.T) {
+	var v waf.WebACL
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafWebAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSWafWebAclConfigRuleGroup(wafAclName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSWafWebAclExists("aws_waf_web_acl.waf_acl", &v),
+					resource.TestCheckResourceAttr(
+						"aws_waf_web_acl.waf_acl", "name", wafAclName),
+					resource.TestCheckResourceAttr(
+						"aws_waf_web_acl.waf_acl", "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSWafWebAcl_disappears(t *testing.T) {
 	var v waf.WebACL
 	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(5))
@@ -365,3 +480,43 @@ resource "aws_waf_web_acl" "waf_acl" {
   }
 }`, name, name, name, name, name)
 }
+
+func testAccAWSWafWebAclConfigRuleGroup(name string) string {
+	return fmt.Sprintf(`resource "aws_waf_rule" "wafrule" {
+  name        = "%s"
+  metric_name = "%s"
+}
+
+resource "aws_waf_rule_group" "wafrulegroup" {
+  name        = "%s"
+  metric_name = "%s"
+
+  activated_rule {
+    action {
+      type = "COUNT"
+    }
+
+    priority = 50
+    rule_id  = "${aws_waf_rule.wafrule.id}"
+  }
+}
+
+resource "aws_waf_web_acl" "waf_acl" {
+  name        = "%s"
+  metric_name = "%s"
+
+  default_action {
+    type = "ALLOW"
+  }
+
+  rules {
+    override_action {
+      type = "NONE"
+    }
+
+    priority = 1
+    type     = "GROUP"
+    rule_id  = "${aws_waf_rule_group.wafrulegroup.id}"
+  }
+}`, name, name, name, name, name, name)
+}
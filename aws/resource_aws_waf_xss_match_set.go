@@ -167,48 +167,3 @@ func updateXssMatchSetResource(id string, oldT, newT []interface{}, conn *waf.WA
 
 	return nil
 }
-
-func flattenWafXssMatchTuples(ts []*waf.XssMatchTuple) []interface{} {
-	out := make([]interface{}, len(ts), len(ts))
-	for i, t := range ts {
-		m := make(map[string]interface{})
-		m["field_to_match"] = flattenFieldToMatch(t.FieldToMatch)
-		m["text_transformation"] = *t.TextTransformation
-		out[i] = m
-	}
-	return out
-}
-
-func diffWafXssMatchSetTuples(oldT, newT []interface{}) []*waf.XssMatchSetUpdate {
-	updates := make([]*waf.XssMatchSetUpdate, 0)
-
-	for _, od := range oldT {
-		tuple := od.(map[string]interface{})
-
-		if idx, contains := sliceContainsMap(newT, tuple); contains {
-			newT = append(newT[:idx], newT[idx+1:]...)
-			continue
-		}
-
-		updates = append(updates, &waf.XssMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionDelete),
-			XssMatchTuple: &waf.XssMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-
-	for _, nd := range newT {
-		tuple := nd.(map[string]interface{})
-
-		updates = append(updates, &waf.XssMatchSetUpdate{
-			Action: aws.String(waf.ChangeActionInsert),
-			XssMatchTuple: &waf.XssMatchTuple{
-				FieldToMatch:       expandFieldToMatch(tuple["field_to_match"].(*schema.Set).List()[0].(map[string]interface{})),
-				TextTransformation: aws.String(tuple["text_transformation"].(string)),
-			},
-		})
-	}
-	return updates
-}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRateBasedRule_basic(t *testing.T) {
+	var v waf.RateBasedRule
+	resourceName := "aws_wafregional_rate_based_rule.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRateBasedRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalRateBasedRuleConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalRateBasedRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", name),
+					resource.TestCheckResourceAttr(resourceName, "rate_key", "IP"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalRateBasedRuleConfig_rateLimit(name, 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalRateBasedRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit", "3000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRateBasedRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_rate_based_rule" {
+			continue
+		}
+
+		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.Rule.RuleId) == rs.Primary.ID {
+			return fmt.Errorf("WAF Regional RateBasedRule %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalRateBasedRuleExists(n string, v *waf.RateBasedRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional RateBasedRule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetRateBasedRule(&waf.GetRateBasedRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.Rule.RuleId) != rs.Primary.ID {
+			return fmt.Errorf("WAF Regional RateBasedRule (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.Rule
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalRateBasedRuleConfig(name string) string {
+	return testAccAWSWafRegionalRateBasedRuleConfig_rateLimit(name, 2000)
+}
+
+func testAccAWSWafRegionalRateBasedRuleConfig_rateLimit(name string, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "test" {
+  name = "%s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_wafregional_rate_based_rule" "test" {
+  name        = "%s"
+  metric_name = "%s"
+  rate_key    = "IP"
+  rate_limit  = %d
+
+  predicate {
+    type    = "IPMatch"
+    data_id = "${aws_wafregional_ipset.test.id}"
+    negated = false
+  }
+}
+`, name, name, name, rateLimit)
+}
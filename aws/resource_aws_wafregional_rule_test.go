@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalRule_basic(t *testing.T) {
+	var v waf.Rule
+	resourceName := "aws_wafregional_rule.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalRuleConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", name),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSWafRegionalRuleConfig_noPredicates(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalRuleExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "predicate.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_rule" {
+			continue
+		}
+
+		resp, err := conn.GetRule(&waf.GetRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.Rule.RuleId) == rs.Primary.ID {
+			return fmt.Errorf("WAF Regional Rule %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalRuleExists(n string, v *waf.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetRule(&waf.GetRuleInput{
+			RuleId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.Rule.RuleId) != rs.Primary.ID {
+			return fmt.Errorf("WAF Regional Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.Rule
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_ipset" "test" {
+  name = "%s"
+
+  ip_set_descriptor {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_wafregional_rule" "test" {
+  name        = "%s"
+  metric_name = "%s"
+
+  predicate {
+    type    = "IPMatch"
+    data_id = "${aws_wafregional_ipset.test.id}"
+    negated = false
+  }
+}
+`, name, name, name)
+}
+
+func testAccAWSWafRegionalRuleConfig_noPredicates(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule" "test" {
+  name        = "%s"
+  metric_name = "%s"
+}
+`, name, name)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalSqlInjectionMatchSet_basic(t *testing.T) {
+	var v waf.SqlInjectionMatchSet
+	resourceName := "aws_wafregional_sql_injection_match_set.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalSqlInjectionMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalSqlInjectionMatchSetConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalSqlInjectionMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "sql_injection_match_tuple.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalSqlInjectionMatchSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_sql_injection_match_set" {
+			continue
+		}
+
+		resp, err := conn.GetSqlInjectionMatchSet(&waf.GetSqlInjectionMatchSetInput{
+			SqlInjectionMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.SqlInjectionMatchSet.SqlInjectionMatchSetId) == rs.Primary.ID {
+			return fmt.Errorf("WAF Regional SqlInjectionMatchSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalSqlInjectionMatchSetExists(n string, v *waf.SqlInjectionMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional SqlInjectionMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetSqlInjectionMatchSet(&waf.GetSqlInjectionMatchSetInput{
+			SqlInjectionMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.SqlInjectionMatchSet.SqlInjectionMatchSetId) != rs.Primary.ID {
+			return fmt.Errorf("WAF Regional SqlInjectionMatchSet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.SqlInjectionMatchSet
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalSqlInjectionMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_sql_injection_match_set" "test" {
+  name = "%s"
+
+  sql_injection_match_tuple {
+    text_transformation = "URL_DECODE"
+
+    field_to_match {
+      type = "QUERY_STRING"
+    }
+  }
+}
+`, name)
+}
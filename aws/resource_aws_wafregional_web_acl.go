@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsWafRegionalWebAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalWebAclCreate,
+		Read:   resourceAwsWafRegionalWebAclRead,
+		Update: resourceAwsWafRegionalWebAclUpdate,
+		Delete: resourceAwsWafRegionalWebAclDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateWafMetricName,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  waf.WafRuleTypeRegular,
+							ValidateFunc: validation.StringInSlice([]string{
+								waf.WafRuleTypeRegular,
+								waf.WafRuleTypeRateBased,
+							}, false),
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalWebAclCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	wr := newWafRegionalRetryer(conn, region)
+	out, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		params := &waf.CreateWebACLInput{
+			ChangeToken:   token,
+			DefaultAction: expandWafAction(d.Get("default_action").([]interface{})),
+			MetricName:    aws.String(d.Get("metric_name").(string)),
+			Name:          aws.String(d.Get("name").(string)),
+		}
+
+		return conn.CreateWebACL(params)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating WAF Regional Web ACL: %s", err)
+	}
+	resp := out.(*waf.CreateWebACLOutput)
+	d.SetId(*resp.WebACL.WebACLId)
+
+	// Rules can only be added once the Web ACL exists
+	if rules := d.Get("rule").(*schema.Set).List(); len(rules) > 0 {
+		err := updateWafRegionalWebAclResource(d.Id(), nil, []interface{}{}, rules, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error adding rules to WAF Regional Web ACL (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWafRegionalWebAclRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	params := &waf.GetWebACLInput{
+		WebACLId: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetWebACL(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Web ACL (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	if err := d.Set("default_action", []interface{}{flattenWafAction(resp.WebACL.DefaultAction)}); err != nil {
+		return fmt.Errorf("error setting default_action: %s", err)
+	}
+	d.Set("name", resp.WebACL.Name)
+	d.Set("metric_name", resp.WebACL.MetricName)
+	if err := d.Set("rule", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
+		return fmt.Errorf("error setting rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWafRegionalWebAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	if d.HasChange("default_action") || d.HasChange("rule") {
+		var defaultAction *waf.WafAction
+		if d.HasChange("default_action") {
+			defaultAction = expandWafAction(d.Get("default_action").([]interface{}))
+		}
+
+		o, n := d.GetChange("rule")
+		oldR, newR := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+		err := updateWafRegionalWebAclResource(d.Id(), defaultAction, oldR, newR, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error updating WAF Regional Web ACL (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWafRegionalWebAclRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+	region := meta.(*AWSClient).region
+
+	// First, need to delete all rules
+	rules := d.Get("rule").(*schema.Set).List()
+	if len(rules) > 0 {
+		noRules := []interface{}{}
+		err := updateWafRegionalWebAclResource(d.Id(), nil, rules, noRules, conn, region)
+		if err != nil {
+			return fmt.Errorf("Error removing rules from WAF Regional Web ACL (%s): %s", d.Id(), err)
+		}
+	}
+
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.DeleteWebACLInput{
+			ChangeToken: token,
+			WebACLId:    aws.String(d.Id()),
+		}
+
+		log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", req)
+		return conn.DeleteWebACL(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting WAF Regional Web ACL (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func updateWafRegionalWebAclResource(id string, defaultAction *waf.WafAction, oldR, newR []interface{}, conn *wafregional.WAFRegional, region string) error {
+	wr := newWafRegionalRetryer(conn, region)
+	_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
+		req := &waf.UpdateWebACLInput{
+			ChangeToken:   token,
+			DefaultAction: defaultAction,
+			Updates:       diffWafWebAclRules(oldR, newR),
+			WebACLId:      aws.String(id),
+		}
+
+		log.Printf("[INFO] Updating WAF Regional Web ACL: %s", req)
+		return conn.UpdateWebACL(req)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating WAF Regional Web ACL: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWafRegionalWebAclAssociationCreate,
+		Read:   resourceAwsWafRegionalWebAclAssociationRead,
+		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"web_acl_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsWafRegionalWebAclAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	webAclId := d.Get("web_acl_id").(string)
+	resourceArn := d.Get("resource_arn").(string)
+
+	log.Printf("[INFO] Associating WAF Regional Web ACL (%s) with resource (%s)", webAclId, resourceArn)
+
+	params := &wafregional.AssociateWebACLInput{
+		WebACLId:    aws.String(webAclId),
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	_, err := conn.AssociateWebACL(params)
+	if err != nil {
+		return fmt.Errorf("Error associating WAF Regional Web ACL (%s) with resource (%s): %s", webAclId, resourceArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", webAclId, resourceArn))
+
+	return resourceAwsWafRegionalWebAclAssociationRead(d, meta)
+}
+
+func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	webAclId, resourceArn, err := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := &wafregional.GetWebACLForResourceInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	resp, err := conn.GetWebACLForResource(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading WAF Regional Web ACL association (%s): %s", d.Id(), err)
+	}
+
+	if resp.WebACLSummary == nil || aws.StringValue(resp.WebACLSummary.WebACLId) != webAclId {
+		log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("web_acl_id", webAclId)
+	d.Set("resource_arn", resourceArn)
+
+	return nil
+}
+
+func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).wafregionalconn
+
+	_, resourceArn, err := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Disassociating WAF Regional Web ACL from resource (%s)", resourceArn)
+
+	params := &wafregional.DisassociateWebACLInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	_, err = conn.DisassociateWebACL(params)
+	if err != nil {
+		if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+			return nil
+		}
+		return fmt.Errorf("Error disassociating WAF Regional Web ACL from resource (%s): %s", resourceArn, err)
+	}
+
+	return nil
+}
+
+// The resource ARN itself contains colons, so only the first one
+// separates it from the Web ACL ID.
+func resourceAwsWafRegionalWebAclAssociationParseId(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected WEB-ACL-ID:RESOURCE-ARN", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,136 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
+	resourceName := "aws_wafregional_web_acl_association.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalWebAclAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalWebAclAssociationConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalWebAclAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_id", "aws_wafregional_web_acl.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_alb.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_web_acl_association" {
+			continue
+		}
+
+		_, resourceArn, err := resourceAwsWafRegionalWebAclAssociationParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if resp.WebACLSummary != nil {
+			return fmt.Errorf("WAF Regional Web ACL association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalWebAclAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional Web ACL association ID is set")
+		}
+
+		webAclId, resourceArn, err := resourceAwsWafRegionalWebAclAssociationParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetWebACLForResource(&wafregional.GetWebACLForResourceInput{
+			ResourceArn: aws.String(resourceArn),
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.WebACLSummary == nil || aws.StringValue(resp.WebACLSummary.WebACLId) != webAclId {
+			return fmt.Errorf("WAF Regional Web ACL association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalWebAclAssociationConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-wafregional-web-acl-association"
+  }
+}
+
+resource "aws_subnet" "test" {
+  count             = 2
+  vpc_id            = "${aws_vpc.test.id}"
+  cidr_block        = "10.1.${count.index}.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+}
+
+resource "aws_alb" "test" {
+  internal = true
+  subnets  = ["${aws_subnet.test.*.id}"]
+}
+
+resource "aws_wafregional_web_acl" "test" {
+  name        = "%s"
+  metric_name = "%s"
+
+  default_action {
+    type = "ALLOW"
+  }
+}
+
+resource "aws_wafregional_web_acl_association" "test" {
+  web_acl_id   = "${aws_wafregional_web_acl.test.id}"
+  resource_arn = "${aws_alb.test.arn}"
+}
+`, name, name)
+}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSWafRegionalXssMatchSet_basic(t *testing.T) {
+	var v waf.XssMatchSet
+	resourceName := "aws_wafregional_xss_match_set.test"
+	name := fmt.Sprintf("tfacc%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSWafRegionalXssMatchSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSWafRegionalXssMatchSetConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSWafRegionalXssMatchSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "xss_match_tuple.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSWafRegionalXssMatchSetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_wafregional_xss_match_set" {
+			continue
+		}
+
+		resp, err := conn.GetXssMatchSet(&waf.GetXssMatchSetInput{
+			XssMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(resp.XssMatchSet.XssMatchSetId) == rs.Primary.ID {
+			return fmt.Errorf("WAF Regional XssMatchSet %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSWafRegionalXssMatchSetExists(n string, v *waf.XssMatchSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WAF Regional XssMatchSet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		resp, err := conn.GetXssMatchSet(&waf.GetXssMatchSetInput{
+			XssMatchSetId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(resp.XssMatchSet.XssMatchSetId) != rs.Primary.ID {
+			return fmt.Errorf("WAF Regional XssMatchSet (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *resp.XssMatchSet
+		return nil
+	}
+}
+
+func testAccAWSWafRegionalXssMatchSetConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_xss_match_set" "test" {
+  name = "%s"
+
+  xss_match_tuple {
+    text_transformation = "NONE"
+
+    field_to_match {
+      type = "URI"
+    }
+  }
+
+  xss_match_tuple {
+    text_transformation = "NONE"
+
+    field_to_match {
+      type = "QUERY_STRING"
+    }
+  }
+}
+`, name)
+}
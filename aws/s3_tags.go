@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,17 +11,17 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig, retryConfig *awsRetryConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
+		create, remove := diffTagsS3(tagsFromMapS3(o, ignoreConfig), tagsFromMapS3(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+			_, err := retryOnAwsCodes(retryConfig, "s3", []string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
 				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
 					Bucket: aws.String(d.Get("bucket").(string)),
 				})
@@ -40,7 +39,7 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 				},
 			}
 
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+			_, err := retryOnAwsCodes(retryConfig, "s3", []string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
 				return conn.PutBucketTagging(req)
 			})
 			if err != nil {
@@ -55,7 +54,7 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
+func diffTagsS3(oldTags, newTags []*s3.Tag, ignoreConfig *ignoreTagsConfig) ([]*s3.Tag, []*s3.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -72,18 +71,18 @@ func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
 		}
 	}
 
-	return tagsFromMapS3(create), remove
+	return tagsFromMapS3(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
+func tagsFromMapS3(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*s3.Tag {
 	result := make([]*s3.Tag, 0, len(m))
 	for k, v := range m {
 		t := &s3.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredS3(t) {
+		if !tagIgnoredS3(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -92,10 +91,10 @@ func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapS3(ts []*s3.Tag) map[string]string {
+func tagsToMapS3(ts []*s3.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredS3(t) {
+		if !tagIgnoredS3(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -124,14 +123,6 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredS3(t *s3.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -32,12 +31,201 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+// defaultTagsSchema returns the schema for the provider-level default_tags
+// block.
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+// mergeDefaultTags returns the provider's default tags overlaid with the
+// resource's own tags. Resource-level keys win and ignored default tags are
+// left out, as they would never be read back.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}, ignoreConfig *ignoreTagsConfig) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			merged[k] = v
+		}
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// ignoreTagsSchema returns the schema for the provider-level ignore_tags
+// block.
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+// defaultTagsResource reports whether r has an updatable tags map that
+// the provider's default_tags can be merged into.
+func defaultTagsResource(r *schema.Resource) bool {
+	s, ok := r.Schema["tags"]
+	if !ok {
+		return false
+	}
+	return s.Type == schema.TypeMap && s.Optional && !s.ForceNew && r.Update != nil
+}
+
+// addDefaultTags adds the provider's default_tags to r: resources supporting
+// them keep the tags applied remotely in the computed tags_all attribute,
+// while tags only holds the tags set on the resource itself.
+func addDefaultTags(r *schema.Resource) {
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if err := resourceDefaultTagsCustomizeDiff(diff, meta); err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(diff, meta)
+		}
+		return nil
+	}
+
+	create, read, update := r.Create, r.Read, r.Update
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		return resourceDefaultTagsWrite(create, d, meta)
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		return resourceDefaultTagsRead(read, d, meta)
+	}
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		return resourceDefaultTagsWrite(update, d, meta)
+	}
+}
+
+// resourceDefaultTagsCustomizeDiff plans tags_all: the provider's
+// default_tags merged with the resource's own tags.
+func resourceDefaultTagsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	// ResourceDiff cannot read a map holding values not known until apply,
+	// so the tags are only read once their count is known. The count is
+	// known for every resource in state, as tags are always set on Read.
+	if _, ok := diff.GetOk("tags.%"); !ok {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	client := meta.(*AWSClient)
+	tags := diff.Get("tags").(map[string]interface{})
+	return diff.SetNew("tags_all", mergeDefaultTags(client.defaultTags, tags, client.ignoreTagsConfig))
+}
+
+// resourceDefaultTagsWrite runs the Create or Update function f with tags_all
+// set to the resource's tags merged with the provider's default_tags, which
+// f applies.
+func resourceDefaultTagsWrite(f func(*schema.ResourceData, interface{}) error, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient)
+	tags := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", mergeDefaultTags(client.defaultTags, tags, client.ignoreTagsConfig)); err != nil {
+		return err
+	}
+
+	err := f(d, meta)
+	if d.Id() == "" {
+		return err
+	}
+	if tagsErr := setResourceTags(d, client.defaultTags, tags); err == nil {
+		err = tagsErr
+	}
+	return err
+}
+
+// resourceDefaultTagsRead runs the Read function f, which reads the tags
+// into tags_all, and sets the resource's own tags from them.
+func resourceDefaultTagsRead(f func(*schema.ResourceData, interface{}) error, d *schema.ResourceData, meta interface{}) error {
+	tags := d.Get("tags").(map[string]interface{})
+	if err := f(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return setResourceTags(d, meta.(*AWSClient).defaultTags, tags)
+}
+
+// setResourceTags sets tags to the keys of tags_all that are not default
+// tags or that the resource sets itself, as recorded in tags.
+func setResourceTags(d *schema.ResourceData, defaultTags, tags map[string]interface{}) error {
+	all := d.Get("tags_all").(map[string]interface{})
+	own := make(map[string]interface{}, len(all))
+	for k, v := range all {
+		_, isDefault := defaultTags[k]
+		_, isOwn := tags[k]
+		if !isDefault || isOwn {
+			own[k] = v
+		}
+	}
+	return d.Set("tags", own)
+}
+
+// tagsKey returns the attribute holding the tags applied remotely: tags_all
+// for resources supporting default_tags and tags for everything else.
+func tagsKey(d *schema.ResourceData) string {
+	if o, _ := d.GetChange("tags_all"); o != nil {
+		return "tags_all"
+	}
+	return "tags"
+}
+
+// hasTagsChange reports whether the tags applied remotely changed.
+func hasTagsChange(d *schema.ResourceData) bool {
+	return d.HasChange(tagsKey(d))
+}
+
+// getTagsChange returns the old and new tags applied remotely.
+func getTagsChange(d *schema.ResourceData) (interface{}, interface{}) {
+	return d.GetChange(tagsKey(d))
+}
+
+func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
+		create, remove := diffElbV2Tags(tagsFromMapELBv2(o, ignoreConfig), tagsFromMapELBv2(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -69,12 +257,12 @@ func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
 	return nil
 }
 
-func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
 	if d.HasChange("volume_tags") {
 		oraw, nraw := d.GetChange("volume_tags")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		create, remove := diffTags(tagsFromMap(o, ignoreConfig), tagsFromMap(n, ignoreConfig), ignoreConfig)
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
@@ -128,12 +316,12 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTags(conn *ec2.EC2, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		create, remove := diffTags(tagsFromMap(o, ignoreConfig), tagsFromMap(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -184,7 +372,7 @@ func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
+func diffTags(oldTags, newTags []*ec2.Tag, ignoreConfig *ignoreTagsConfig) ([]*ec2.Tag, []*ec2.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -200,18 +388,18 @@ func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
 		}
 	}
 
-	return tagsFromMap(create), remove
+	return tagsFromMap(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
+func tagsFromMap(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*ec2.Tag {
 	result := make([]*ec2.Tag, 0, len(m))
 	for k, v := range m {
 		t := &ec2.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnored(t) {
+		if !tagIgnored(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -220,10 +408,10 @@ func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
+func tagsToMap(ts []*ec2.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnored(t) {
+		if !tagIgnored(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -231,7 +419,7 @@ func tagsToMap(ts []*ec2.Tag) map[string]string {
 	return result
 }
 
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
+func diffElbV2Tags(oldTags, newTags []*elbv2.Tag, ignoreConfig *ignoreTagsConfig) ([]*elbv2.Tag, []*elbv2.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -248,14 +436,14 @@ func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
 		}
 	}
 
-	return tagsFromMapELBv2(create), remove
+	return tagsFromMapELBv2(create, ignoreConfig), remove
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
+func tagsToMapELBv2(ts []*elbv2.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
+		if !tagIgnoredELBv2(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -264,14 +452,14 @@ func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
 }
 
 // tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
+func tagsFromMapELBv2(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*elbv2.Tag {
 	var result []*elbv2.Tag
 	for k, v := range m {
 		t := &elbv2.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredELBv2(t) {
+		if !tagIgnoredELBv2(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -281,49 +469,37 @@ func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
 
 // tagIgnored compares a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnored(t *ec2.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
 
 // and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredELBv2(t *elbv2.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
 
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
-func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
+func tagsToMapDynamoDb(ts []*dynamodb.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredGeneric(*t.Key, ignoreConfig) {
+			result[*t.Key] = *t.Value
+		}
 	}
 	return result
 }
 
 // tagsFromMapDynamoDb returns the tags for a given map
-func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
+func tagsFromMapDynamoDb(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*dynamodb.Tag {
 	result := make([]*dynamodb.Tag, 0, len(m))
 	for k, v := range m {
 		t := &dynamodb.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		result = append(result, t)
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			result = append(result, t)
+		}
 	}
 	return result
 }
@@ -332,13 +508,13 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // This is needed because dynamodb requires a completely different set and delete
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
-func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
 		arn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
+		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o, ignoreConfig), tagsFromMapDynamoDb(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -389,7 +565,7 @@ func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
 // diffTagsDynamoDb takes a local set of dynamodb tags and the ones found remotely
 // and returns the set of tags that must be created as a map, and returns a list of tag keys
 // that must be destroyed.
-func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
+func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag, ignoreConfig *ignoreTagsConfig) ([]*dynamodb.Tag, []*string) {
 	create := make(map[string]interface{})
 	for _, t := range newTags {
 		create[*t.Key] = *t.Value
@@ -403,5 +579,5 @@ func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*str
 			remove = append(remove, t.Key)
 		}
 	}
-	return tagsFromMapDynamoDb(create), remove
+	return tagsFromMapDynamoDb(create, ignoreConfig), remove
 }
@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 )
@@ -11,7 +8,7 @@ import (
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*elasticbeanstalk.Tag) {
+func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag, ignoreConfig *ignoreTagsConfig) ([]*elasticbeanstalk.Tag, []*elasticbeanstalk.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -28,18 +25,18 @@ func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbean
 		}
 	}
 
-	return tagsFromMapBeanstalk(create), remove
+	return tagsFromMapBeanstalk(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
+func tagsFromMapBeanstalk(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*elasticbeanstalk.Tag {
 	var result []*elasticbeanstalk.Tag
 	for k, v := range m {
 		t := &elasticbeanstalk.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredBeanstalk(t) {
+		if !tagIgnoredBeanstalk(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -48,10 +45,10 @@ func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
+		if !tagIgnoredBeanstalk(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -61,14 +58,6 @@ func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -50,9 +50,9 @@ func TestDiffBeanstalkTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsBeanstalk(tagsFromMapBeanstalk(tc.Old), tagsFromMapBeanstalk(tc.New))
-		cm := tagsToMapBeanstalk(c)
-		rm := tagsToMapBeanstalk(r)
+		c, r := diffTagsBeanstalk(tagsFromMapBeanstalk(tc.Old, nil), tagsFromMapBeanstalk(tc.New, nil), nil)
+		cm := tagsToMapBeanstalk(c, nil)
+		rm := tagsToMapBeanstalk(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsBeanstalk(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredBeanstalk(tag) {
+		if !tagIgnoredBeanstalk(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsBeanstalk(t *testing.T) {
 func testAccCheckBeanstalkTags(
 	ts *[]*elasticbeanstalk.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapBeanstalk(*ts)
+		m := tagsToMapBeanstalk(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -8,12 +8,12 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
+		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o, ignoreConfig), tagsFromMapCloudFront(n, ignoreConfig), ignoreConfig)
 
 		if len(remove) > 0 {
 			log.Printf("[DEBUG] Removing tags: %s", remove)
@@ -50,7 +50,7 @@ func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn
 
 	return nil
 }
-func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
+func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags, ignoreConfig *ignoreTagsConfig) ([]*cloudfront.Tag, []*cloudfront.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags.Items {
@@ -67,17 +67,19 @@ func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, [
 		}
 	}
 
-	createTags := tagsFromMapCloudFront(create)
+	createTags := tagsFromMapCloudFront(create, ignoreConfig)
 	return createTags.Items, remove
 }
 
-func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
+func tagsFromMapCloudFront(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) *cloudfront.Tags {
 	result := make([]*cloudfront.Tag, 0, len(m))
 	for k, v := range m {
-		result = append(result, &cloudfront.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			result = append(result, &cloudfront.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
 	}
 
 	tags := &cloudfront.Tags{
@@ -87,11 +89,13 @@ func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
 	return tags
 }
 
-func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
+func tagsToMapCloudFront(ts *cloudfront.Tags, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 
 	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredGeneric(*t.Key, ignoreConfig) {
+			result[*t.Key] = *t.Value
+		}
 	}
 
 	return result
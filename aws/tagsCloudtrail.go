@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudtrail(tagsFromMapCloudtrail(o), tagsFromMapCloudtrail(n))
+		create, remove := diffTagsCloudtrail(tagsFromMapCloudtrail(o, ignoreConfig), tagsFromMapCloudtrail(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -49,7 +48,7 @@ func setTagsCloudtrail(conn *cloudtrail.CloudTrail, d *schema.ResourceData) erro
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag) ([]*cloudtrail.Tag, []*cloudtrail.Tag) {
+func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag, ignoreConfig *ignoreTagsConfig) ([]*cloudtrail.Tag, []*cloudtrail.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -66,18 +65,18 @@ func diffTagsCloudtrail(oldTags, newTags []*cloudtrail.Tag) ([]*cloudtrail.Tag,
 		}
 	}
 
-	return tagsFromMapCloudtrail(create), remove
+	return tagsFromMapCloudtrail(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
+func tagsFromMapCloudtrail(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*cloudtrail.Tag {
 	var result []*cloudtrail.Tag
 	for k, v := range m {
 		t := &cloudtrail.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredCloudtrail(t) {
+		if !tagIgnoredCloudtrail(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -86,10 +85,10 @@ func tagsFromMapCloudtrail(m map[string]interface{}) []*cloudtrail.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
+func tagsToMapCloudtrail(ts []*cloudtrail.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredCloudtrail(t) {
+		if !tagIgnoredCloudtrail(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -99,14 +98,6 @@ func tagsToMapCloudtrail(ts []*cloudtrail.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredCloudtrail(t *cloudtrail.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredCloudtrail(t *cloudtrail.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
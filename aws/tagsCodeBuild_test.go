@@ -73,7 +73,7 @@ func TestIgnoringTagsCodeBuild(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredCodeBuild(tag) {
+		if !tagIgnoredCodeBuild(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData, resourceId string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsDS(tagsFromMapDS(o), tagsFromMapDS(n))
+		create, remove := diffTagsDS(tagsFromMapDS(o, ignoreConfig), tagsFromMapDS(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -52,7 +51,7 @@ func setTagsDS(conn *directoryservice.DirectoryService, d *schema.ResourceData,
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.Tag, []*directoryservice.Tag) {
+func diffTagsDS(oldTags, newTags []*directoryservice.Tag, ignoreConfig *ignoreTagsConfig) ([]*directoryservice.Tag, []*directoryservice.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -69,18 +68,18 @@ func diffTagsDS(oldTags, newTags []*directoryservice.Tag) ([]*directoryservice.T
 		}
 	}
 
-	return tagsFromMapDS(create), remove
+	return tagsFromMapDS(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
+func tagsFromMapDS(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*directoryservice.Tag {
 	result := make([]*directoryservice.Tag, 0, len(m))
 	for k, v := range m {
 		t := &directoryservice.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredDS(t) {
+		if !tagIgnoredDS(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -89,10 +88,10 @@ func tagsFromMapDS(m map[string]interface{}) []*directoryservice.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
+func tagsToMapDS(ts []*directoryservice.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredDS(t) {
+		if !tagIgnoredDS(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -102,14 +101,6 @@ func tagsToMapDS(ts []*directoryservice.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredDS(t *directoryservice.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredDS(t *directoryservice.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
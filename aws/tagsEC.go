@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEC(tagsFromMapEC(o), tagsFromMapEC(n))
+		create, remove := diffTagsEC(tagsFromMapEC(o, ignoreConfig), tagsFromMapEC(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -52,7 +51,7 @@ func setTagsEC(conn *elasticache.ElastiCache, d *schema.ResourceData, arn string
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*elasticache.Tag) {
+func diffTagsEC(oldTags, newTags []*elasticache.Tag, ignoreConfig *ignoreTagsConfig) ([]*elasticache.Tag, []*elasticache.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -69,18 +68,18 @@ func diffTagsEC(oldTags, newTags []*elasticache.Tag) ([]*elasticache.Tag, []*ela
 		}
 	}
 
-	return tagsFromMapEC(create), remove
+	return tagsFromMapEC(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
+func tagsFromMapEC(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*elasticache.Tag {
 	result := make([]*elasticache.Tag, 0, len(m))
 	for k, v := range m {
 		t := &elasticache.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredEC(t) {
+		if !tagIgnoredEC(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -89,10 +88,10 @@ func tagsFromMapEC(m map[string]interface{}) []*elasticache.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
+func tagsToMapEC(ts []*elasticache.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredEC(t) {
+		if !tagIgnoredEC(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -102,14 +101,6 @@ func tagsToMapEC(ts []*elasticache.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredEC(t *elasticache.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredEC(t *elasticache.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -50,9 +50,9 @@ func TestDiffelasticacheTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsEC(tagsFromMapEC(tc.Old), tagsFromMapEC(tc.New))
-		cm := tagsToMapEC(c)
-		rm := tagsToMapEC(r)
+		c, r := diffTagsEC(tagsFromMapEC(tc.Old, nil), tagsFromMapEC(tc.New, nil), nil)
+		cm := tagsToMapEC(c, nil)
+		rm := tagsToMapEC(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsEC(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredEC(tag) {
+		if !tagIgnoredEC(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsEC(t *testing.T) {
 func testAccCheckelasticacheTags(
 	ts []*elasticache.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapEC(ts)
+		m := tagsToMapEC(ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/efs"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsEFS(conn *efs.EFS, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEFS(tagsFromMapEFS(o), tagsFromMapEFS(n))
+		create, remove := diffTagsEFS(tagsFromMapEFS(o, ignoreConfig), tagsFromMapEFS(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -51,7 +50,7 @@ func setTagsEFS(conn *efs.EFS, d *schema.ResourceData) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsEFS(oldTags, newTags []*efs.Tag) ([]*efs.Tag, []*efs.Tag) {
+func diffTagsEFS(oldTags, newTags []*efs.Tag, ignoreConfig *ignoreTagsConfig) ([]*efs.Tag, []*efs.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -68,18 +67,18 @@ func diffTagsEFS(oldTags, newTags []*efs.Tag) ([]*efs.Tag, []*efs.Tag) {
 		}
 	}
 
-	return tagsFromMapEFS(create), remove
+	return tagsFromMapEFS(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapEFS(m map[string]interface{}) []*efs.Tag {
+func tagsFromMapEFS(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*efs.Tag {
 	var result []*efs.Tag
 	for k, v := range m {
 		t := &efs.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredEFS(t) {
+		if !tagIgnoredEFS(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -88,10 +87,10 @@ func tagsFromMapEFS(m map[string]interface{}) []*efs.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapEFS(ts []*efs.Tag) map[string]string {
+func tagsToMapEFS(ts []*efs.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredEFS(t) {
+		if !tagIgnoredEFS(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -101,14 +100,6 @@ func tagsToMapEFS(ts []*efs.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredEFS(t *efs.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredEFS(t *efs.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
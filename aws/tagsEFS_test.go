@@ -50,9 +50,9 @@ func TestDiffEFSTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsEFS(tagsFromMapEFS(tc.Old), tagsFromMapEFS(tc.New))
-		cm := tagsToMapEFS(c)
-		rm := tagsToMapEFS(r)
+		c, r := diffTagsEFS(tagsFromMapEFS(tc.Old, nil), tagsFromMapEFS(tc.New, nil), nil)
+		cm := tagsToMapEFS(c, nil)
+		rm := tagsToMapEFS(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsEFS(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredEFS(tag) {
+		if !tagIgnoredEFS(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsEFS(t *testing.T) {
 func testAccCheckEFSTags(
 	ts *[]*efs.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapEFS(*ts)
+		m := tagsToMapEFS(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsELB(conn *elb.ELB, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsELB(tagsFromMapELB(o), tagsFromMapELB(n))
+		create, remove := diffTagsELB(tagsFromMapELB(o, ignoreConfig), tagsFromMapELB(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -51,7 +50,7 @@ func setTagsELB(conn *elb.ELB, d *schema.ResourceData) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsELB(oldTags, newTags []*elb.Tag) ([]*elb.Tag, []*elb.Tag) {
+func diffTagsELB(oldTags, newTags []*elb.Tag, ignoreConfig *ignoreTagsConfig) ([]*elb.Tag, []*elb.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -68,18 +67,18 @@ func diffTagsELB(oldTags, newTags []*elb.Tag) ([]*elb.Tag, []*elb.Tag) {
 		}
 	}
 
-	return tagsFromMapELB(create), remove
+	return tagsFromMapELB(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapELB(m map[string]interface{}) []*elb.Tag {
+func tagsFromMapELB(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*elb.Tag {
 	var result []*elb.Tag
 	for k, v := range m {
 		t := &elb.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredELB(t) {
+		if !tagIgnoredELB(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -88,10 +87,10 @@ func tagsFromMapELB(m map[string]interface{}) []*elb.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapELB(ts []*elb.Tag) map[string]string {
+func tagsToMapELB(ts []*elb.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredELB(t) {
+		if !tagIgnoredELB(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -101,14 +100,6 @@ func tagsToMapELB(ts []*elb.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredELB(t *elb.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredELB(t *elb.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
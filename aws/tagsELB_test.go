@@ -50,9 +50,9 @@ func TestDiffELBTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsELB(tagsFromMapELB(tc.Old), tagsFromMapELB(tc.New))
-		cm := tagsToMapELB(c)
-		rm := tagsToMapELB(r)
+		c, r := diffTagsELB(tagsFromMapELB(tc.Old, nil), tagsFromMapELB(tc.New, nil), nil)
+		cm := tagsToMapELB(c, nil)
+		rm := tagsToMapELB(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsELB(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredELB(tag) {
+		if !tagIgnoredELB(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsELB(t *testing.T) {
 func testAccCheckELBTags(
 	ts *[]*elb.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapELB(*ts)
+		m := tagsToMapELB(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
@@ -3,18 +3,46 @@ package aws
 import (
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 )
 
+// ignoreTagsConfig holds the tag keys and key prefixes configured through
+// the provider's ignore_tags block. A nil *ignoreTagsConfig ignores nothing
+// beyond the AWS specific tags.
+type ignoreTagsConfig struct {
+	keys        map[string]struct{}
+	keyPrefixes []string
+}
+
+// newIgnoreTagsConfig returns the configuration for the given tag keys and
+// key prefixes, or nil if there is nothing to ignore.
+func newIgnoreTagsConfig(keys, keyPrefixes []string) *ignoreTagsConfig {
+	if len(keys) == 0 && len(keyPrefixes) == 0 {
+		return nil
+	}
+
+	c := &ignoreTagsConfig{
+		keys:        make(map[string]struct{}, len(keys)),
+		keyPrefixes: keyPrefixes,
+	}
+	for _, k := range keys {
+		c.keys[k] = struct{}{}
+	}
+	return c
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*string, map[string]*string) {
+func diffTagsGeneric(oldTags, newTags map[string]interface{}, ignoreConfig *ignoreTagsConfig) (map[string]*string, map[string]*string) {
 	// First, we're creating everything we have
 	create := make(map[string]*string)
 	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			create[k] = aws.String(v.(string))
+		}
 	}
 
 	// Build the map of what to remove
@@ -31,10 +59,10 @@ func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*strin
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
+func tagsFromMapGeneric(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) map[string]*string {
 	result := make(map[string]*string)
 	for k, v := range m {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, ignoreConfig) {
 			result[k] = aws.String(v.(string))
 		}
 	}
@@ -43,10 +71,10 @@ func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
 }
 
 // tagsToMap turns the tags into a map.
-func tagsToMapGeneric(ts map[string]*string) map[string]string {
+func tagsToMapGeneric(ts map[string]*string, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for k, v := range ts {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, ignoreConfig) {
 			result[k] = aws.StringValue(v)
 		}
 	}
@@ -55,8 +83,9 @@ func tagsToMapGeneric(ts map[string]*string) map[string]string {
 }
 
 // compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredGeneric(k string) bool {
+// be ignored or not. Besides the AWS specific tags, the keys and key
+// prefixes from the provider's ignore_tags block are ignored.
+func tagIgnoredGeneric(k string, ignoreConfig *ignoreTagsConfig) bool {
 	filter := []string{"^aws:"}
 	for _, v := range filter {
 		log.Printf("[DEBUG] Matching %v with %v\n", v, k)
@@ -65,5 +94,20 @@ func tagIgnoredGeneric(k string) bool {
 			return true
 		}
 	}
+
+	if ignoreConfig == nil {
+		return false
+	}
+
+	if _, ok := ignoreConfig.keys[k]; ok {
+		log.Printf("[DEBUG] Found tag %s in ignore_tags keys, ignoring.\n", k)
+		return true
+	}
+	for _, prefix := range ignoreConfig.keyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			log.Printf("[DEBUG] Found tag %s matching ignore_tags key prefix %s, ignoring.\n", k, prefix)
+			return true
+		}
+	}
 	return false
 }
@@ -47,9 +47,9 @@ func TestDiffGenericTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsGeneric(tc.Old, tc.New)
-		cm := tagsToMapGeneric(c)
-		rm := tagsToMapGeneric(r)
+		c, r := diffTagsGeneric(tc.Old, tc.New, nil)
+		cm := tagsToMapGeneric(c, nil)
+		rm := tagsToMapGeneric(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -66,8 +66,57 @@ func TestIgnoringTagsGeneric(t *testing.T) {
 		"aws:foo:bar":                   aws.String("baz"),
 	}
 	for k, v := range ignoredTags {
-		if !tagIgnoredGeneric(k) {
+		if !tagIgnoredGeneric(k, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", k, *v)
 		}
 	}
 }
+
+// go test -v -run="TestIgnoringTagsGeneric_ignoreTags"
+func TestIgnoringTagsGeneric_ignoreTags(t *testing.T) {
+	ignoreConfig := newIgnoreTagsConfig([]string{"Owner"}, []string{"kubernetes.io/"})
+
+	ignoredTags := []string{
+		"aws:cloudformation:logical-id",
+		"Owner",
+		"kubernetes.io/cluster/example",
+	}
+	for _, k := range ignoredTags {
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			t.Fatalf("Tag %v not ignored, but should be!", k)
+		}
+	}
+
+	notIgnoredTags := []string{
+		"Name",
+		"OwnerTeam",
+		"kubernetes.io",
+	}
+	for _, k := range notIgnoredTags {
+		if tagIgnoredGeneric(k, ignoreConfig) {
+			t.Fatalf("Tag %v ignored, but should not be!", k)
+		}
+	}
+
+	c, r := diffTagsGeneric(
+		map[string]interface{}{"Name": "foo"},
+		map[string]interface{}{"Name": "bar", "Owner": "ops"},
+		ignoreConfig,
+	)
+	if _, ok := c["Owner"]; ok {
+		t.Fatalf("bad create: %#v", c)
+	}
+	if len(r) != 1 {
+		t.Fatalf("bad remove: %#v", r)
+	}
+
+	merged := mergeDefaultTags(
+		map[string]interface{}{"Environment": "test", "Owner": "ops"},
+		map[string]interface{}{"Name": "foo"},
+		ignoreConfig,
+	)
+	expected := map[string]interface{}{"Environment": "test", "Name": "foo"}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("bad merged tags: %#v", merged)
+	}
+}
@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/inspector"
 )
@@ -11,7 +8,7 @@ import (
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsInspector(oldTags, newTags []*inspector.ResourceGroupTag) ([]*inspector.ResourceGroupTag, []*inspector.ResourceGroupTag) {
+func diffTagsInspector(oldTags, newTags []*inspector.ResourceGroupTag, ignoreConfig *ignoreTagsConfig) ([]*inspector.ResourceGroupTag, []*inspector.ResourceGroupTag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -28,18 +25,18 @@ func diffTagsInspector(oldTags, newTags []*inspector.ResourceGroupTag) ([]*inspe
 		}
 	}
 
-	return tagsFromMapInspector(create), remove
+	return tagsFromMapInspector(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTag {
+func tagsFromMapInspector(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*inspector.ResourceGroupTag {
 	var result []*inspector.ResourceGroupTag
 	for k, v := range m {
 		t := &inspector.ResourceGroupTag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredInspector(t) {
+		if !tagIgnoredInspector(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -48,10 +45,10 @@ func tagsFromMapInspector(m map[string]interface{}) []*inspector.ResourceGroupTa
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapInspector(ts []*inspector.ResourceGroupTag) map[string]string {
+func tagsToMapInspector(ts []*inspector.ResourceGroupTag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredInspector(t) {
+		if !tagIgnoredInspector(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -61,14 +58,6 @@ func tagsToMapInspector(ts []*inspector.ResourceGroupTag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredInspector(t *inspector.ResourceGroupTag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredInspector(t *inspector.ResourceGroupTag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKMS(tagsFromMapKMS(o), tagsFromMapKMS(n))
+		create, remove := diffTagsKMS(tagsFromMapKMS(o, ignoreConfig), tagsFromMapKMS(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -52,7 +51,7 @@ func setTagsKMS(conn *kms.KMS, d *schema.ResourceData, keyId string) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsKMS(oldTags, newTags []*kms.Tag) ([]*kms.Tag, []*kms.Tag) {
+func diffTagsKMS(oldTags, newTags []*kms.Tag, ignoreConfig *ignoreTagsConfig) ([]*kms.Tag, []*kms.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -69,18 +68,18 @@ func diffTagsKMS(oldTags, newTags []*kms.Tag) ([]*kms.Tag, []*kms.Tag) {
 		}
 	}
 
-	return tagsFromMapKMS(create), remove
+	return tagsFromMapKMS(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
+func tagsFromMapKMS(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*kms.Tag {
 	result := make([]*kms.Tag, 0, len(m))
 	for k, v := range m {
 		t := &kms.Tag{
 			TagKey:   aws.String(k),
 			TagValue: aws.String(v.(string)),
 		}
-		if !tagIgnoredKMS(t) {
+		if !tagIgnoredKMS(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -89,10 +88,10 @@ func tagsFromMapKMS(m map[string]interface{}) []*kms.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapKMS(ts []*kms.Tag) map[string]string {
+func tagsToMapKMS(ts []*kms.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredKMS(t) {
+		if !tagIgnoredKMS(t, ignoreConfig) {
 			result[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
 		}
 	}
@@ -102,14 +101,6 @@ func tagsToMapKMS(ts []*kms.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredKMS(t *kms.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.TagKey)
-		if r, _ := regexp.MatchString(v, *t.TagKey); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.TagKey, *t.TagValue)
-			return true
-		}
-	}
-	return false
+func tagIgnoredKMS(t *kms.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.TagKey, ignoreConfig)
 }
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -12,12 +11,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
+		create, remove := diffTagsRDS(tagsFromMapRDS(o, ignoreConfig), tagsFromMapRDS(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -53,7 +52,7 @@ func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
+func diffTagsRDS(oldTags, newTags []*rds.Tag, ignoreConfig *ignoreTagsConfig) ([]*rds.Tag, []*rds.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -70,18 +69,18 @@ func diffTagsRDS(oldTags, newTags []*rds.Tag) ([]*rds.Tag, []*rds.Tag) {
 		}
 	}
 
-	return tagsFromMapRDS(create), remove
+	return tagsFromMapRDS(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
+func tagsFromMapRDS(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*rds.Tag {
 	result := make([]*rds.Tag, 0, len(m))
 	for k, v := range m {
 		t := &rds.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredRDS(t) {
+		if !tagIgnoredRDS(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -90,10 +89,10 @@ func tagsFromMapRDS(m map[string]interface{}) []*rds.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapRDS(ts []*rds.Tag) map[string]string {
+func tagsToMapRDS(ts []*rds.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredRDS(t) {
+		if !tagIgnoredRDS(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -101,7 +100,7 @@ func tagsToMapRDS(ts []*rds.Tag) map[string]string {
 	return result
 }
 
-func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
 	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
@@ -115,19 +114,11 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 		dt = resp.TagList
 	}
 
-	return d.Set("tags", tagsToMapRDS(dt))
+	return d.Set("tags_all", tagsToMapRDS(dt, ignoreConfig))
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredRDS(t *rds.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -2,19 +2,18 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsRedshift(tagsFromMapRedshift(o), tagsFromMapRedshift(n))
+		create, remove := diffTagsRedshift(tagsFromMapRedshift(o, ignoreConfig), tagsFromMapRedshift(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -47,7 +46,7 @@ func setTagsRedshift(conn *redshift.Redshift, d *schema.ResourceData, arn string
 	return nil
 }
 
-func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*redshift.Tag) {
+func diffTagsRedshift(oldTags, newTags []*redshift.Tag, ignoreConfig *ignoreTagsConfig) ([]*redshift.Tag, []*redshift.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -64,17 +63,17 @@ func diffTagsRedshift(oldTags, newTags []*redshift.Tag) ([]*redshift.Tag, []*red
 		}
 	}
 
-	return tagsFromMapRedshift(create), remove
+	return tagsFromMapRedshift(create, ignoreConfig), remove
 }
 
-func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
+func tagsFromMapRedshift(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*redshift.Tag {
 	result := make([]*redshift.Tag, 0, len(m))
 	for k, v := range m {
 		t := &redshift.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredRedshift(t) {
+		if !tagIgnoredRedshift(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -82,10 +81,10 @@ func tagsFromMapRedshift(m map[string]interface{}) []*redshift.Tag {
 	return result
 }
 
-func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
+func tagsToMapRedshift(ts []*redshift.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredRedshift(t) {
+		if !tagIgnoredRedshift(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -95,14 +94,6 @@ func tagsToMapRedshift(ts []*redshift.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredRedshift(t *redshift.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredRedshift(t *redshift.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -6,30 +6,34 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func dmsTagsToMap(tags []*dms.Tag) map[string]string {
+func dmsTagsToMap(tags []*dms.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 
 	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
+		if !tagIgnoredGeneric(*tag.Key, ignoreConfig) {
+			result[*tag.Key] = *tag.Value
+		}
 	}
 
 	return result
 }
 
-func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
+func dmsTagsFromMap(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*dms.Tag {
 	result := make([]*dms.Tag, 0, len(m))
 
 	for k, v := range m {
-		result = append(result, &dms.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
+		if !tagIgnoredGeneric(k, ignoreConfig) {
+			result = append(result, &dms.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
 	}
 
 	return result
 }
 
-func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
+func dmsDiffTags(oldTags, newTags []*dms.Tag, ignoreConfig *ignoreTagsConfig) ([]*dms.Tag, []*dms.Tag) {
 	create := make(map[string]interface{})
 	for _, t := range newTags {
 		create[*t.Key] = *t.Value
@@ -43,7 +47,7 @@ func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
 		}
 	}
 
-	return dmsTagsFromMap(create), remove
+	return dmsTagsFromMap(create, ignoreConfig), remove
 }
 
 func dmsGetTagKeys(tags []*dms.Tag) []*string {
@@ -59,12 +63,12 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
+		add, remove := dmsDiffTags(dmsTagsFromMap(o, meta.(*AWSClient).ignoreTagsConfig), dmsTagsFromMap(n, meta.(*AWSClient).ignoreTagsConfig), meta.(*AWSClient).ignoreTagsConfig)
 
 		if len(remove) > 0 {
 			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
@@ -20,7 +20,7 @@ func TestDmsTagsToMap(t *testing.T) {
 		},
 	}
 
-	result := dmsTagsToMap(tags)
+	result := dmsTagsToMap(tags, nil)
 
 	for _, tag := range tags {
 		if v, ok := result[*tag.Key]; ok {
@@ -39,7 +39,7 @@ func TestDmsTagsFromMap(t *testing.T) {
 		"test-key-2": "test-value-2",
 	}
 
-	result := dmsTagsFromMap(tagMap)
+	result := dmsTagsFromMap(tagMap, nil)
 
 	for k, v := range tagMap {
 		found := false
@@ -80,9 +80,9 @@ func TestDmsDiffTags(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		ar, rr := dmsDiffTags(dmsTagsFromMap(c.o), dmsTagsFromMap(c.n))
-		a := dmsTagsToMap(ar)
-		r := dmsTagsToMap(rr)
+		ar, rr := dmsDiffTags(dmsTagsFromMap(c.o, nil), dmsTagsFromMap(c.n, nil), nil)
+		a := dmsTagsToMap(ar, nil)
+		r := dmsTagsToMap(rr, nil)
 
 		if !reflect.DeepEqual(a, c.a) {
 			t.Fatalf("Add tags mismatch: Actual %#v; Expected %#v", a, c.a)
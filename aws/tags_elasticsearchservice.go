@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *schema.ResourceData, arn string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsElasticsearchService(tagsFromMapElasticsearchService(o), tagsFromMapElasticsearchService(n))
+		create, remove := diffTagsElasticsearchService(tagsFromMapElasticsearchService(o, ignoreConfig), tagsFromMapElasticsearchService(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -51,7 +50,7 @@ func setTagsElasticsearchService(conn *elasticsearch.ElasticsearchService, d *sc
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag) ([]*elasticsearch.Tag, []*elasticsearch.Tag) {
+func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag, ignoreConfig *ignoreTagsConfig) ([]*elasticsearch.Tag, []*elasticsearch.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -68,18 +67,18 @@ func diffTagsElasticsearchService(oldTags, newTags []*elasticsearch.Tag) ([]*ela
 		}
 	}
 
-	return tagsFromMapElasticsearchService(create), remove
+	return tagsFromMapElasticsearchService(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.Tag {
+func tagsFromMapElasticsearchService(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*elasticsearch.Tag {
 	var result []*elasticsearch.Tag
 	for k, v := range m {
 		t := &elasticsearch.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredElasticsearchService(t) {
+		if !tagIgnoredElasticsearchService(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -88,10 +87,10 @@ func tagsFromMapElasticsearchService(m map[string]interface{}) []*elasticsearch.
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
+func tagsToMapElasticsearchService(ts []*elasticsearch.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredElasticsearchService(t) {
+		if !tagIgnoredElasticsearchService(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -101,14 +100,6 @@ func tagsToMapElasticsearchService(ts []*elasticsearch.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredElasticsearchService(t *elasticsearch.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredElasticsearchService(t *elasticsearch.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
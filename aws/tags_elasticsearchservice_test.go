@@ -50,9 +50,9 @@ func TestDiffElasticsearchServiceTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTagsElasticsearchService(tagsFromMapElasticsearchService(tc.Old), tagsFromMapElasticsearchService(tc.New))
-		cm := tagsToMapElasticsearchService(c)
-		rm := tagsToMapElasticsearchService(r)
+		c, r := diffTagsElasticsearchService(tagsFromMapElasticsearchService(tc.Old, nil), tagsFromMapElasticsearchService(tc.New, nil), nil)
+		cm := tagsToMapElasticsearchService(c, nil)
+		rm := tagsToMapElasticsearchService(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -73,7 +73,7 @@ func TestIgnoringTagsElasticsearchService(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredElasticsearchService(tag) {
+		if !tagIgnoredElasticsearchService(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
@@ -83,7 +83,7 @@ func TestIgnoringTagsElasticsearchService(t *testing.T) {
 func testAccCheckElasticsearchServiceTags(
 	ts *[]*elasticsearch.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMapElasticsearchService(*ts)
+		m := tagsToMapElasticsearchService(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
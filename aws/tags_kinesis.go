@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
@@ -11,15 +10,15 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
+func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData, ignoreConfig *ignoreTagsConfig) error {
 
 	sn := d.Get("name").(string)
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsKinesis(tagsFromMapKinesis(o), tagsFromMapKinesis(n))
+		create, remove := diffTagsKinesis(tagsFromMapKinesis(o, ignoreConfig), tagsFromMapKinesis(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		if len(remove) > 0 {
@@ -62,7 +61,7 @@ func setTagsKinesis(conn *kinesis.Kinesis, d *schema.ResourceData) error {
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsKinesis(oldTags, newTags []*kinesis.Tag) ([]*kinesis.Tag, []*kinesis.Tag) {
+func diffTagsKinesis(oldTags, newTags []*kinesis.Tag, ignoreConfig *ignoreTagsConfig) ([]*kinesis.Tag, []*kinesis.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -79,18 +78,18 @@ func diffTagsKinesis(oldTags, newTags []*kinesis.Tag) ([]*kinesis.Tag, []*kinesi
 		}
 	}
 
-	return tagsFromMapKinesis(create), remove
+	return tagsFromMapKinesis(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapKinesis(m map[string]interface{}) []*kinesis.Tag {
+func tagsFromMapKinesis(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*kinesis.Tag {
 	var result []*kinesis.Tag
 	for k, v := range m {
 		t := &kinesis.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredKinesis(t) {
+		if !tagIgnoredKinesis(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -99,10 +98,10 @@ func tagsFromMapKinesis(m map[string]interface{}) []*kinesis.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapKinesis(ts []*kinesis.Tag) map[string]string {
+func tagsToMapKinesis(ts []*kinesis.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredKinesis(t) {
+		if !tagIgnoredKinesis(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -112,14 +111,6 @@ func tagsToMapKinesis(ts []*kinesis.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredKinesis(t *kinesis.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredKinesis(t *kinesis.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -11,12 +10,12 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType string, ignoreConfig *ignoreTagsConfig) error {
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
-		create, remove := diffTagsR53(tagsFromMapR53(o), tagsFromMapR53(n))
+		create, remove := diffTagsR53(tagsFromMapR53(o, ignoreConfig), tagsFromMapR53(n, ignoreConfig), ignoreConfig)
 
 		// Set tags
 		r := make([]*string, len(remove))
@@ -48,7 +47,7 @@ func setTagsR53(conn *route53.Route53, d *schema.ResourceData, resourceType stri
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
-func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Tag) {
+func diffTagsR53(oldTags, newTags []*route53.Tag, ignoreConfig *ignoreTagsConfig) ([]*route53.Tag, []*route53.Tag) {
 	// First, we're creating everything we have
 	create := make(map[string]interface{})
 	for _, t := range newTags {
@@ -65,18 +64,18 @@ func diffTagsR53(oldTags, newTags []*route53.Tag) ([]*route53.Tag, []*route53.Ta
 		}
 	}
 
-	return tagsFromMapR53(create), remove
+	return tagsFromMapR53(create, ignoreConfig), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
-func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
+func tagsFromMapR53(m map[string]interface{}, ignoreConfig *ignoreTagsConfig) []*route53.Tag {
 	result := make([]*route53.Tag, 0, len(m))
 	for k, v := range m {
 		t := &route53.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		if !tagIgnoredRoute53(t) {
+		if !tagIgnoredRoute53(t, ignoreConfig) {
 			result = append(result, t)
 		}
 	}
@@ -85,10 +84,10 @@ func tagsFromMapR53(m map[string]interface{}) []*route53.Tag {
 }
 
 // tagsToMap turns the list of tags into a map.
-func tagsToMapR53(ts []*route53.Tag) map[string]string {
+func tagsToMapR53(ts []*route53.Tag, ignoreConfig *ignoreTagsConfig) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		if !tagIgnoredRoute53(t) {
+		if !tagIgnoredRoute53(t, ignoreConfig) {
 			result[*t.Key] = *t.Value
 		}
 	}
@@ -98,14 +97,6 @@ func tagsToMapR53(ts []*route53.Tag) map[string]string {
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
-func tagIgnoredRoute53(t *route53.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+func tagIgnoredRoute53(t *route53.Tag, ignoreConfig *ignoreTagsConfig) bool {
+	return tagIgnoredGeneric(*t.Key, ignoreConfig)
 }
@@ -7,7 +7,10 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/hil/ast"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -50,9 +53,9 @@ func TestDiffTags(t *testing.T) {
 	}
 
 	for i, tc := range cases {
-		c, r := diffTags(tagsFromMap(tc.Old), tagsFromMap(tc.New))
-		cm := tagsToMap(c)
-		rm := tagsToMap(r)
+		c, r := diffTags(tagsFromMap(tc.Old, nil), tagsFromMap(tc.New, nil), nil)
+		cm := tagsToMap(c, nil)
+		rm := tagsToMap(r, nil)
 		if !reflect.DeepEqual(cm, tc.Create) {
 			t.Fatalf("%d: bad create: %#v", i, cm)
 		}
@@ -74,17 +77,368 @@ func TestIgnoringTags(t *testing.T) {
 		Value: aws.String("baz"),
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnored(tag) {
+		if !tagIgnored(tag, nil) {
 			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
 		}
 	}
 }
 
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Defaults, Tags, Expected map[string]interface{}
+	}{
+		// No defaults
+		{
+			Defaults: map[string]interface{}{},
+			Tags: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// No resource tags
+		{
+			Defaults: map[string]interface{}{
+				"Environment": "test",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Environment": "test",
+			},
+		},
+
+		// Resource tags win
+		{
+			Defaults: map[string]interface{}{
+				"Environment": "test",
+				"Owner":       "ops",
+			},
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "dev",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "test",
+				"Name":        "foo",
+				"Owner":       "dev",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		merged := mergeDefaultTags(tc.Defaults, tc.Tags, nil)
+		if !reflect.DeepEqual(merged, tc.Expected) {
+			t.Fatalf("%d: bad merged tags: %#v", i, merged)
+		}
+	}
+}
+
+func TestResourceDefaultTagsCustomizeDiff(t *testing.T) {
+	newResource := func() *schema.Resource {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema(),
+			},
+			Create: func(*schema.ResourceData, interface{}) error { return nil },
+			Read:   func(*schema.ResourceData, interface{}) error { return nil },
+			Update: func(*schema.ResourceData, interface{}) error { return nil },
+		}
+		addDefaultTags(r)
+		return r
+	}
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Environment": "test",
+			"Owner":       "ops",
+		},
+	}
+
+	cases := []struct {
+		Config   map[string]interface{}
+		State    map[string]string
+		Expected map[string]string
+	}{
+		// Not known until apply
+		{
+			Config: map[string]interface{}{},
+			Expected: map[string]string{
+				"tags_all.%": "",
+			},
+		},
+
+		// Defaults only
+		{
+			Config: map[string]interface{}{},
+			State: map[string]string{
+				"tags.%": "0",
+			},
+			Expected: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.Environment": "test",
+				"tags_all.Owner":       "ops",
+			},
+		},
+
+		// Resource tags win
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Name":  "foo",
+					"Owner": "dev",
+				},
+			},
+			Expected: map[string]string{
+				"tags.%":               "2",
+				"tags.Name":            "foo",
+				"tags.Owner":           "dev",
+				"tags_all.%":           "3",
+				"tags_all.Environment": "test",
+				"tags_all.Name":        "foo",
+				"tags_all.Owner":       "dev",
+			},
+		},
+
+		// Already tagged with the merged set
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Name": "foo",
+				},
+			},
+			State: map[string]string{
+				"tags.%":               "1",
+				"tags.Name":            "foo",
+				"tags_all.%":           "3",
+				"tags_all.Environment": "test",
+				"tags_all.Name":        "foo",
+				"tags_all.Owner":       "ops",
+			},
+			Expected: nil,
+		},
+
+		// Default tag removed remotely
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Name": "foo",
+				},
+			},
+			State: map[string]string{
+				"tags.%":               "1",
+				"tags.Name":            "foo",
+				"tags_all.%":           "2",
+				"tags_all.Environment": "test",
+				"tags_all.Name":        "foo",
+			},
+			Expected: map[string]string{
+				"tags_all.%":     "3",
+				"tags_all.Owner": "ops",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		var state *terraform.InstanceState
+		if tc.State != nil {
+			state = &terraform.InstanceState{
+				ID:         "foo",
+				Attributes: tc.State,
+			}
+		}
+
+		rc, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		d, err := newResource().Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		actual := map[string]string{}
+		if d != nil {
+			for k, attr := range d.Attributes {
+				if attr.Old != attr.New || attr.NewComputed {
+					actual[k] = attr.New
+				}
+			}
+		}
+		if tc.Expected == nil {
+			tc.Expected = map[string]string{}
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad diff: %#v", i, actual)
+		}
+	}
+}
+
+func TestResourceDefaultTagsCustomizeDiff_unknownTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: func(*schema.ResourceData, interface{}) error { return nil },
+		Read:   func(*schema.ResourceData, interface{}) error { return nil },
+		Update: func(*schema.ResourceData, interface{}) error { return nil },
+	}
+	addDefaultTags(r)
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Environment": "test",
+		},
+	}
+
+	rc, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name": "${var.name}",
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = rc.Interpolate(map[string]ast.Variable{
+		"var.name": {
+			Value: config.UnknownVariableValue,
+			Type:  ast.TypeUnknown,
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	states := []*terraform.InstanceState{
+		nil,
+		{
+			ID: "foo",
+			Attributes: map[string]string{
+				"tags.%":    "1",
+				"tags.Name": "foo",
+			},
+		},
+	}
+	for i, state := range states {
+		d, err := r.Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if attr, ok := d.Attributes["tags_all.%"]; !ok || !attr.NewComputed {
+			t.Fatalf("%d: expected tags_all to be computed: %#v", i, d.Attributes)
+		}
+	}
+}
+
+func TestResourceDefaultTagsApply(t *testing.T) {
+	// remote holds the tags of the resource as the API would return them.
+	var remote map[string]interface{}
+	var created, removed map[string]*string
+	write := func(d *schema.ResourceData, meta interface{}) error {
+		oraw, nraw := getTagsChange(d)
+		created, removed = diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}), nil)
+		for k := range removed {
+			delete(remote, k)
+		}
+		for k, v := range created {
+			remote[k] = *v
+		}
+		d.SetId("foo")
+		return d.Set("tags_all", remote)
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: write,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags_all", remote)
+		},
+		Update: write,
+	}
+	addDefaultTags(r)
+
+	rc, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name":  "foo",
+			"Owner": "dev",
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	apply := func(state *terraform.InstanceState, meta *AWSClient) *terraform.InstanceState {
+		d, err := r.Diff(state, terraform.NewResourceConfig(rc), meta)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		state, err = r.Apply(state, d, meta)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		return state
+	}
+
+	remote = map[string]interface{}{}
+	state := apply(nil, &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Environment": "test",
+			"Owner":       "ops",
+		},
+	})
+
+	expected := map[string]interface{}{"Environment": "test", "Name": "foo", "Owner": "dev"}
+	if !reflect.DeepEqual(remote, expected) {
+		t.Fatalf("bad remote tags after create: %#v", remote)
+	}
+	if state.Attributes["tags.%"] != "2" || state.Attributes["tags.Owner"] != "dev" {
+		t.Fatalf("bad tags after create: %#v", state.Attributes)
+	}
+	if state.Attributes["tags_all.%"] != "3" || state.Attributes["tags_all.Environment"] != "test" {
+		t.Fatalf("bad tags_all after create: %#v", state.Attributes)
+	}
+
+	// Replacing a default tag removes the old one.
+	state = apply(state, &AWSClient{
+		defaultTags: map[string]interface{}{
+			"CostCenter": "42",
+		},
+	})
+
+	expected = map[string]interface{}{"CostCenter": "42", "Name": "foo", "Owner": "dev"}
+	if !reflect.DeepEqual(remote, expected) {
+		t.Fatalf("bad remote tags after update: %#v", remote)
+	}
+	if _, ok := removed["Environment"]; !ok {
+		t.Fatalf("expected Environment to be removed: %#v", removed)
+	}
+	if state.Attributes["tags.%"] != "2" || state.Attributes["tags_all.%"] != "3" {
+		t.Fatalf("bad tags after update: %#v", state.Attributes)
+	}
+
+	// A tag added outside Terraform shows up in tags as well.
+	remote["Team"] = "infra"
+	state, err = r.Refresh(state, &AWSClient{
+		defaultTags: map[string]interface{}{
+			"CostCenter": "42",
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if state.Attributes["tags.%"] != "3" || state.Attributes["tags.Team"] != "infra" || state.Attributes["tags_all.%"] != "4" {
+		t.Fatalf("bad tags after refresh: %#v", state.Attributes)
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		m := tagsToMap(*ts)
+		m := tagsToMap(*ts, nil)
 		v, ok := m[key]
 		if value != "" && !ok {
 			return fmt.Errorf("Missing tag: %s", key)
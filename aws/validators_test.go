@@ -2774,6 +2774,44 @@ func TestValidateDxConnectionBandWidth(t *testing.T) {
 	}
 }
 
+func TestValidateAmazonSideAsn(t *testing.T) {
+	validAsns := []string{
+		"64512",
+		"64513",
+		"65533",
+		"65534",
+		"4200000000",
+		"4200000001",
+		"4294967293",
+		"4294967294",
+	}
+	for _, v := range validAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid ASN: %q", v, errors)
+		}
+	}
+
+	invalidAsns := []string{
+		"1",
+		"ABCDEFG",
+		"",
+		"7224",
+		"9059",
+		"64511",
+		"65535",
+		"4199999999",
+		"4294967295",
+		"9999999999",
+	}
+	for _, v := range invalidAsns {
+		_, errors := validateAmazonSideAsn(v, "amazon_side_asn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ASN", v)
+		}
+	}
+}
+
 func TestValidateCognitoUserPoolReplyEmailAddress(t *testing.T) {
 	validTypes := []string{
 		"foo@gmail.com",
@@ -2800,6 +2838,98 @@ func TestValidateCognitoUserPoolReplyEmailAddress(t *testing.T) {
 	}
 }
 
+func TestValidateCognitoUserGroupName(t *testing.T) {
+	validValues := []string{
+		"foo",
+		"7346241598935552",
+		"foo_bar",
+		"foo:bar",
+		"foo/bar",
+		"foo-bar",
+		"$foobar",
+		strings.Repeat("W", 128),
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoUserGroupName(s, "name")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito User Group Name: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		strings.Repeat("W", 129),
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoUserGroupName(s, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito User Group Name: %v", s, errors)
+		}
+	}
+}
+
+func TestValidateCognitoUserPoolDomain(t *testing.T) {
+	validTypes := []string{
+		"valid-domain",
+		"validdomain",
+		"val1d-d0main",
+	}
+	for _, v := range validTypes {
+		_, errors := validateCognitoUserPoolDomain(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Cognito User Pool Domain: %q", v, errors)
+		}
+	}
+
+	invalidTypes := []string{
+		"UpperCase",
+		"-invalid",
+		"invalid-",
+		strings.Repeat("i", 64),
+	}
+	for _, v := range invalidTypes {
+		_, errors := validateCognitoUserPoolDomain(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Cognito User Pool Domain", v)
+		}
+	}
+}
+
+func TestValidateCognitoResourceServerScopeName(t *testing.T) {
+	validValues := []string{
+		"valid",
+		"3ne",
+		"ThisIsAValidScopeName",
+		"this-is-a-valid-scope-name",
+		"this.is_a:valid$scope@name",
+		strings.Repeat("W", 256),
+	}
+
+	for _, s := range validValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "name")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid Cognito Resource Server Scope Name: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"not valid",
+		"not/valid",
+		"not\\valid",
+		strings.Repeat("W", 257),
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateCognitoResourceServerScopeName(s, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Cognito Resource Server Scope Name: %v", s, errors)
+		}
+	}
+}
+
 func TestResourceAWSElastiCacheReplicationGroupAuthTokenValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -2839,3 +2969,184 @@ func TestResourceAWSElastiCacheReplicationGroupAuthTokenValidation(t *testing.T)
 		}
 	}
 }
+
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"fooBAR123",
+		"(foo)/bar_baz.qux-1",
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"fo",
+		"foo bar",
+		"foo*bar",
+		randomString(126),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template name", v)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateNamePrefix(t *testing.T) {
+	validPrefixes := []string{
+		"f",
+		"foo-bar_",
+	}
+	for _, v := range validPrefixes {
+		_, errors := validateLaunchTemplateNamePrefix(v, "name_prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template name prefix: %q", v, errors)
+		}
+	}
+
+	invalidPrefixes := []string{
+		"foo bar",
+		randomString(100),
+	}
+	for _, v := range invalidPrefixes {
+		_, errors := validateLaunchTemplateNamePrefix(v, "name_prefix")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template name prefix", v)
+		}
+	}
+}
+
+func TestValidateRFC3339TimeString(t *testing.T) {
+	validTimes := []string{
+		"2018-01-01T00:00:00Z",
+		"2018-01-01T00:00:00+02:00",
+	}
+	for _, v := range validTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid RFC3339 timestamp: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"2018-01-01",
+		"2018-01-01 00:00:00",
+		"not a time",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateRFC3339TimeString(v, "valid_until")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid RFC3339 timestamp", v)
+		}
+	}
+}
+
+func TestValidateBase64Encoded(t *testing.T) {
+	validValues := []string{
+		"dGVzdA==",
+		"",
+	}
+	for _, v := range validValues {
+		_, errors := validateBase64Encoded(v, "user_data")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid base64: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"#!/bin/bash",
+		"dGVzdA",
+	}
+	for _, v := range invalidValues {
+		_, errors := validateBase64Encoded(v, "user_data")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid base64", v)
+		}
+	}
+}
+
+func TestValidateAwsDynamoDbGlobalTableName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"tf-acc-test.global_table",
+		strings.Repeat("W", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateAwsDynamoDbGlobalTableName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DynamoDB Global Table name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"invalid name",
+		"invalid/name",
+		strings.Repeat("W", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAwsDynamoDbGlobalTableName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DynamoDB Global Table name", v)
+		}
+	}
+}
+
+func TestValidateAwsDynamoDbBackupName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"tf-acc-test.backup_1",
+		strings.Repeat("W", 255),
+	}
+	for _, v := range validNames {
+		_, errors := validateAwsDynamoDbBackupName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DynamoDB Backup name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"invalid name",
+		"invalid:name",
+		strings.Repeat("W", 256),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAwsDynamoDbBackupName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DynamoDB Backup name", v)
+		}
+	}
+}
+
+func TestValidatePositiveDuration(t *testing.T) {
+	validValues := []string{
+		"1ms",
+		"30s",
+		"2m",
+	}
+	for _, v := range validValues {
+		_, errors := validatePositiveDuration(v, "max_retry_backoff")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid duration: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"0",
+		"0s",
+		"-1s",
+		"30",
+		"invalid",
+	}
+	for _, v := range invalidValues {
+		_, errors := validatePositiveDuration(v, "max_retry_backoff")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid duration", v)
+		}
+	}
+}